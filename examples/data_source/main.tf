@@ -25,3 +25,19 @@ data "aap_inventory" "sample" {
 output "inventory_details" {
   value = data.aap_inventory.sample
 }
+
+data "aap_organization" "default" {
+  name = "Default"
+}
+
+output "organization_details" {
+  value = data.aap_organization.default
+}
+
+data "aap_execution_environment" "default" {
+  name = "Default execution environment"
+}
+
+output "execution_environment_details" {
+  value = data.aap_execution_environment.default
+}
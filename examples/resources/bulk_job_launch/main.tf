@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_bulk_job_launch" "sample" {
+  name                = "Provision web servers"
+  inventory_id        = 2
+  wait_for_completion = true
+  jobs = [
+    {
+      job_template_id = 9
+      limit           = "webservers"
+      extra_vars      = jsonencode({ "resource_state" : "present" })
+    },
+    {
+      job_template_id = 10
+      inventory_id    = 3
+    },
+  ]
+}
+
+output "bulk_job_launch" {
+  value = aap_bulk_job_launch.sample
+}
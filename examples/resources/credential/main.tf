@@ -0,0 +1,49 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+variable "machine_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "aap_credential" "sample_machine" {
+  name               = "My machine credential"
+  description        = "A new credential for testing"
+  credential_type_id = 1
+  organization       = 1
+  inputs = {
+    username      = "admin"
+    password      = var.machine_password
+    become_method = "sudo"
+  }
+}
+
+resource "aap_credential" "sample_personal" {
+  name               = "My personal SCM credential"
+  credential_type_id = 2
+  user               = 1
+  inputs = {
+    username     = "git"
+    ssh_key_data = file("~/.ssh/id_ed25519")
+  }
+}
+
+resource "aap_job_template" "sample" {
+  name                    = "My new job template"
+  project                 = 6
+  playbook                = "hello_world.yml"
+  ask_inventory_on_launch = true
+  credentials             = [aap_credential.sample_machine.id]
+}
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+variable "vault_token" {
+  type      = string
+  sensitive = true
+}
+
+# HashiCorp Vault Secret Lookup credential
+resource "aap_credential" "vault" {
+  name               = "My Vault lookup"
+  credential_type_id = 21
+  organization       = 1
+  inputs = {
+    url         = "https://vault.example.com:8200"
+    token       = var.vault_token
+    api_version = "v2"
+  }
+}
+
+resource "aap_credential" "machine" {
+  name               = "My machine credential"
+  credential_type_id = 1
+  organization       = 1
+  inputs = {
+    username = "admin"
+  }
+}
+
+# Look up the password of the machine credential in Vault
+resource "aap_credential_input_source" "machine_password" {
+  target_credential = aap_credential.machine.id
+  input_field_name  = "password"
+  source_credential = aap_credential.vault.id
+  metadata = {
+    secret_path = "/kv/machines"
+    secret_key  = "admin_password"
+  }
+}
@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_credential_type" "sample" {
+  name        = "My API token"
+  description = "A new credential type for testing"
+  kind        = "cloud"
+  inputs = yamlencode({
+    fields = [
+      { id = "url", label = "API URL", type = "string" },
+      { id = "token", label = "API token", type = "string", secret = true },
+    ]
+    required = ["url", "token"]
+  })
+  # Injector templates may only reference the ids declared in the inputs
+  injectors = <<EOT
+env:
+  API_URL: "{{ url }}"
+  API_TOKEN: "{{ token }}"
+EOT
+}
+
+resource "aap_credential" "sample" {
+  name               = "My API token credential"
+  credential_type_id = aap_credential_type.sample.id
+  organization       = 1
+  inputs = {
+    url   = "https://api.example.com"
+    token = "changeme"
+  }
+}
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_execution_environment" "sample" {
+  name         = "My custom execution environment"
+  description  = "A custom execution environment for testing"
+  organization = 1
+  image        = "quay.io/ansible/awx-ee:latest"
+  pull         = "missing"
+}
+
+data "aap_execution_environment" "sample" {
+  name = aap_execution_environment.sample.name
+}
+
+resource "aap_job" "sample" {
+  job_template_id          = 9
+  inventory_id             = 2
+  execution_environment_id = data.aap_execution_environment.sample.id
+}
@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_instance_group" "sample" {
+  name                       = "My execution nodes"
+  policy_instance_percentage = 50
+  policy_instance_minimum    = 1
+  policy_instance_list       = ["exec-node-1.example.com"]
+  max_concurrent_jobs        = 10
+  max_forks                  = 200
+}
+
+resource "aap_instance_group" "sample_container" {
+  name               = "My OpenShift container group"
+  is_container_group = true
+  credential         = 8
+  pod_spec_override = yamlencode({
+    "apiVersion" : "v1",
+    "kind" : "Pod",
+    "metadata" : { "namespace" : "automation-jobs" },
+    "spec" : {
+      "containers" : [{
+        "name" : "worker",
+        "image" : "quay.io/ansible/awx-ee:latest",
+        "args" : ["ansible-runner", "worker", "--private-data-dir=/runner"]
+      }]
+    }
+  })
+}
+
+resource "aap_organization" "sample" {
+  name = "My organization"
+  # Jobs run on the container group first, then on the execution nodes
+  instance_groups = [aap_instance_group.sample_container.id, aap_instance_group.sample.id]
+}
+
+resource "aap_inventory" "sample" {
+  name            = "My inventory"
+  organization    = aap_organization.sample.id
+  instance_groups = [aap_instance_group.sample.id]
+}
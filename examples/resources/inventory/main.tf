@@ -59,6 +59,23 @@ resource "aap_inventory" "sample_xyz" {
   variables   = "os: Linux\nautomation: ansible-devel"
 }
 
+resource "aap_inventory" "sample_smart" {
+  name        = "My new smart inventory"
+  kind        = "smart"
+  host_filter = "name__icontains=web and enabled=true"
+}
+
+resource "aap_inventory" "sample_constructed" {
+  name                = "My new constructed inventory"
+  kind                = "constructed"
+  input_inventory_ids = [aap_inventory.sample_foo.id, aap_inventory.sample_bar.id]
+  source_vars = yamlencode({
+    "plugin" : "constructed",
+    "groups" : { "linux" : "os == 'Linux'" }
+  })
+  limit = "linux"
+}
+
 output "inventory_foo" {
   value = aap_inventory.sample_foo
 }
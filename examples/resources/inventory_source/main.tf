@@ -0,0 +1,49 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_inventory" "sample" {
+  name = "My new inventory"
+}
+
+resource "aap_project" "sample" {
+  name            = "My inventory project"
+  scm_type        = "git"
+  scm_url         = "https://github.com/ansible/test-playbooks"
+  update_on_apply = true
+}
+
+resource "aap_inventory_source" "sample_scm" {
+  name             = "My inventory file source"
+  inventory        = aap_inventory.sample.id
+  source           = "scm"
+  source_project   = aap_project.sample.id
+  source_path      = "inventories/inventory.ini"
+  overwrite        = true
+  update_on_launch = true
+  # Sync the hosts before jobs use the inventory
+  update_on_apply = true
+}
+
+resource "aap_inventory_source" "sample_ec2" {
+  name        = "My EC2 source"
+  inventory   = aap_inventory.sample.id
+  source      = "ec2"
+  credential  = 5
+  source_vars = yamlencode({ "regions" : ["us-east-1"] })
+}
+
+output "synced_hosts" {
+  value = aap_inventory_source.sample_scm.host_count
+}
@@ -40,6 +40,7 @@ resource "aap_job" "sample_bar" {
 resource "aap_job" "sample_baz" {
   job_template_id = 9
   inventory_id    = 2
+  strict_launch   = true
   extra_vars = jsonencode({
     execution_environment_id = "3"
     # Add other variables as needed
@@ -58,6 +59,19 @@ resource "aap_job" "sample_xyz" {
   extra_vars      = "os: Linux\nautomation: ansible-devel"
 }
 
+resource "aap_job" "sample_teardown" {
+  job_template_id         = 9
+  inventory_id            = 2
+  destroy_job_template_id = 10
+  destroy_extra_vars      = jsonencode({ "resource_state" : "absent" })
+}
+
+resource "aap_job" "sample_retry" {
+  job_template_id          = 9
+  inventory_id             = 2
+  relaunch_on_failed_hosts = true
+}
+
 output "job_foo" {
   value = aap_job.sample_foo
 }
@@ -77,3 +91,11 @@ output "job_abc" {
 output "job_xyz" {
   value = aap_job.sample_xyz
 }
+
+output "job_teardown" {
+  value = aap_job.sample_teardown
+}
+
+output "job_retry" {
+  value = aap_job.sample_retry
+}
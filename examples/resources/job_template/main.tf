@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_inventory" "sample" {
+  name = "My new inventory"
+}
+
+resource "aap_label" "sample" {
+  name         = "sample"
+  organization = 1
+}
+
+resource "aap_job_template" "sample" {
+  name        = "My new job template"
+  description = "A new job template for testing"
+  job_type    = "check"
+  project     = 6
+  playbook    = "hello_world.yml"
+  inventory   = aap_inventory.sample.id
+  forks       = 5
+  verbosity   = 1
+  extra_vars = jsonencode({
+    "foo" : "bar"
+  })
+  # Credentials are associated in the order they are listed
+  credentials             = [1, 2]
+  labels                  = [aap_label.sample.id]
+  ask_limit_on_launch     = true
+  ask_variables_on_launch = true
+}
+
+resource "aap_job" "sample" {
+  job_template_id = aap_job_template.sample.id
+  extra_vars      = jsonencode({ "foo" : "baz" })
+}
+
+output "job_template" {
+  value = aap_job_template.sample
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_label" "production" {
+  name         = "production"
+  organization = 1
+}
+
+output "production_label" {
+  value = aap_label.production
+}
@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_notification_template" "slack" {
+  name              = "Slack alerts"
+  description       = "Posts job results to the ops channel"
+  organization      = 1
+  notification_type = "slack"
+  notification_configuration = jsonencode({
+    "channels" : ["#ops"],
+    "token" : var.slack_token
+  })
+}
+
+resource "aap_notification_template" "webhook" {
+  name              = "Webhook alerts"
+  organization      = 1
+  notification_type = "webhook"
+  notification_configuration = yamlencode({
+    "url" : "https://example.com/hooks/aap",
+    "http_method" : "POST",
+    "headers" : {},
+    "username" : "",
+    "password" : "",
+    "disable_ssl_verification" : false
+  })
+}
+
+variable "slack_token" {
+  type      = string
+  sensitive = true
+}
+
+output "slack_notification_template" {
+  value = aap_notification_template.slack.url
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_notification_template" "slack" {
+  name              = "Slack alerts"
+  organization      = 1
+  notification_type = "slack"
+  notification_configuration = jsonencode({
+    "channels" : ["#ops"],
+    "token" : var.slack_token
+  })
+}
+
+resource "aap_notification_template_attachment" "job_template_error" {
+  notification_template = aap_notification_template.slack.id
+  job_template          = 7
+  event                 = "error"
+}
+
+resource "aap_notification_template_attachment" "organization_started" {
+  notification_template = aap_notification_template.slack.id
+  organization          = 1
+  event                 = "started"
+}
+
+variable "slack_token" {
+  type      = string
+  sensitive = true
+}
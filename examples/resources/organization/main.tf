@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_organization" "sample" {
+  name        = "My new organization"
+  description = "A new organization for testing"
+  max_hosts   = 100
+}
+
+resource "aap_inventory" "sample" {
+  name         = "My new inventory"
+  description  = "A new inventory in my new organization"
+  organization = aap_organization.sample.id
+}
+
+output "organization" {
+  value = aap_organization.sample
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_project" "sample_git" {
+  name        = "My new git project"
+  description = "A new project for testing"
+  scm_type    = "git"
+  scm_url     = "https://github.com/ansible/ansible-tower-samples"
+  scm_branch  = "master"
+  # Wait for the playbooks to be available before creating job templates
+  update_on_apply = true
+}
+
+resource "aap_project" "sample_manual" {
+  name       = "My new manual project"
+  local_path = "my_playbooks"
+}
+
+resource "aap_job_template" "sample" {
+  name                    = "My new job template"
+  project                 = aap_project.sample_git.id
+  playbook                = aap_project.sample_git.playbooks[0]
+  ask_inventory_on_launch = true
+}
+
+output "playbooks" {
+  value = aap_project.sample_git.playbooks
+}
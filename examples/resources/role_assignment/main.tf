@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_team" "ops" {
+  name         = "Operations"
+  organization = 1
+}
+
+resource "aap_role_assignment" "ops_execute" {
+  role         = "execute"
+  job_template = 7
+  team         = aap_team.ops.id
+}
+
+resource "aap_role_assignment" "ops_inventory_use" {
+  role      = "use"
+  inventory = 2
+  team      = aap_team.ops.id
+}
+
+resource "aap_role_assignment" "auditor_read" {
+  role    = "read"
+  project = 4
+  user    = 5
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_schedule" "nightly" {
+  name                 = "Nightly run"
+  description          = "Runs the job template every night"
+  unified_job_template = 7
+  rrule                = "DTSTART;TZID=America/New_York:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1"
+  extra_data = jsonencode({
+    "environment" : "production"
+  })
+}
+
+resource "aap_schedule" "weekly_check" {
+  name                 = "Weekly check"
+  unified_job_template = 7
+  rrule                = "DTSTART:20240101T080000Z RRULE:FREQ=WEEKLY;BYDAY=MO,FR EXRULE:FREQ=YEARLY;BYMONTH=12"
+  job_type             = "check"
+  limit                = "webservers"
+  diff_mode            = true
+}
+
+output "nightly_next_run" {
+  value = aap_schedule.nightly.next_run
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_settings" "jobs" {
+  category = "jobs"
+  settings = jsonencode({
+    DEFAULT_JOB_TIMEOUT = 3600
+    AWX_TASK_ENV = {
+      HTTPS_PROXY = "http://proxy.example.com:3128"
+    }
+  })
+}
+
+resource "aap_settings" "ldap" {
+  category = "ldap"
+  settings = <<-EOT
+    AUTH_LDAP_SERVER_URI: ldaps://ldap.example.com
+    AUTH_LDAP_BIND_DN: cn=aap,dc=example,dc=com
+    AUTH_LDAP_BIND_PASSWORD: ${var.ldap_bind_password}
+  EOT
+}
+
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_survey_spec" "deploy" {
+  job_template = 7
+  name         = "Deployment"
+
+  question {
+    variable      = "region"
+    question_name = "Region to deploy to"
+    type          = "multiplechoice"
+    required      = true
+    choices       = ["eu-west", "us-east"]
+    default       = "eu-west"
+  }
+
+  question {
+    variable      = "replicas"
+    question_name = "Number of replicas"
+    type          = "integer"
+    min           = 1
+    max           = 10
+    default       = "3"
+  }
+
+  question {
+    variable      = "api_password"
+    question_name = "API password"
+    type          = "password"
+    required      = true
+    default       = var.api_password
+  }
+}
+
+variable "api_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_system_job" "cleanup_jobs" {
+  system_job_template_id = 1
+  days                   = 90
+  wait_for_completion    = true
+  triggers = {
+    "schedule" : "2024-Q1"
+  }
+}
+
+resource "aap_system_job" "cleanup_activitystream" {
+  system_job_template_id = 2
+  days                   = 30
+  dry_run                = true
+}
+
+output "cleanup_jobs" {
+  value = aap_system_job.cleanup_jobs
+}
+
+output "cleanup_activitystream" {
+  value = aap_system_job.cleanup_activitystream
+}
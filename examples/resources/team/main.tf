@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_team" "ops" {
+  name         = "Operations"
+  description  = "Operations team"
+  organization = 1
+}
+
+output "ops_team" {
+  value = aap_team.ops
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_team" "ops" {
+  name         = "Operations"
+  organization = 1
+}
+
+resource "aap_user" "jdoe" {
+  username = "jdoe"
+  password = var.initial_password
+}
+
+resource "aap_team_membership" "ops_jdoe" {
+  team = aap_team.ops.id
+  user = aap_user.jdoe.id
+}
+
+variable "initial_password" {
+  type      = string
+  sensitive = true
+}
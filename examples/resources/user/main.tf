@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_user" "jdoe" {
+  username   = "jdoe"
+  email      = "jdoe@example.com"
+  first_name = "John"
+  last_name  = "Doe"
+  password   = var.initial_password
+}
+
+resource "aap_user" "admin" {
+  username     = "platform-admin"
+  is_superuser = true
+  password     = var.initial_password
+}
+
+variable "initial_password" {
+  type      = string
+  sensitive = true
+}
+
+output "jdoe_user" {
+  value = aap_user.jdoe.id
+}
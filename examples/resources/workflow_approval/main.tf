@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+variable "workflow_job_id" {
+  type = number
+}
+
+resource "aap_workflow_approval" "change" {
+  workflow_job_id   = var.workflow_job_id
+  node_identifier   = "approve"
+  action            = "approve"
+  wait_for_approval = true
+}
+
+output "approval_status" {
+  value = aap_workflow_approval.change.status
+}
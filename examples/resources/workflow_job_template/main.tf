@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    aap = {
+      source = "ansible/aap"
+    }
+  }
+}
+
+provider "aap" {
+  host                 = "https://localhost:8043"
+  username             = "ansible"
+  password             = "test123!"
+  insecure_skip_verify = true
+}
+
+resource "aap_label" "provisioning" {
+  name         = "provisioning"
+  organization = 1
+}
+
+resource "aap_workflow_job_template" "provisioning" {
+  name         = "Provision servers"
+  organization = 1
+  labels       = [aap_label.provisioning.id]
+
+  node {
+    identifier    = "approve"
+    success_nodes = ["deploy"]
+    approval = {
+      name    = "Approve the provisioning"
+      timeout = 3600
+    }
+  }
+
+  node {
+    identifier           = "deploy"
+    unified_job_template = 7
+    limit                = "webservers"
+    extra_data = jsonencode({
+      "region" : "eu-west-1"
+    })
+    failure_nodes = ["rollback"]
+  }
+
+  node {
+    identifier           = "rollback"
+    unified_job_template = 8
+  }
+}
+
+output "provisioning_workflow" {
+  value = aap_workflow_job_template.provisioning
+}
@@ -0,0 +1,475 @@
+package provider
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Bulk job launch AAP API model
+type BulkJobLaunchAPIModel struct {
+	ID           int64                      `json:"id,omitempty"`
+	Name         string                     `json:"name,omitempty"`
+	URL          string                     `json:"url,omitempty"`
+	Status       string                     `json:"status,omitempty"`
+	Inventory    int64                      `json:"inventory,omitempty"`
+	Organization int64                      `json:"organization,omitempty"`
+	Jobs         []BulkJobLaunchJobAPIModel `json:"jobs,omitempty"`
+}
+
+// Job specification of a bulk job launch AAP API model
+type BulkJobLaunchJobAPIModel struct {
+	TemplateID int64           `json:"unified_job_template"`
+	Inventory  int64           `json:"inventory,omitempty"`
+	Limit      string          `json:"limit,omitempty"`
+	ExtraData  json.RawMessage `json:"extra_data,omitempty"`
+}
+
+// Workflow job node AAP API model, used to retrieve the jobs spawned by a bulk job launch
+type workflowJobNodeAPIModel struct {
+	ID            int64 `json:"id"`
+	Job           int64 `json:"job"`
+	SummaryFields struct {
+		Job struct {
+			Status string `json:"status"`
+		} `json:"job"`
+	} `json:"summary_fields"`
+}
+
+// BulkJobLaunchResourceModel maps the bulk job launch resource schema data.
+type BulkJobLaunchResourceModel struct {
+	ID                types.Int64             `tfsdk:"id"`
+	Name              types.String            `tfsdk:"name"`
+	URL               types.String            `tfsdk:"url"`
+	Status            types.String            `tfsdk:"status"`
+	InventoryID       types.Int64             `tfsdk:"inventory_id"`
+	OrganizationID    types.Int64             `tfsdk:"organization_id"`
+	Jobs              []BulkJobLaunchJobModel `tfsdk:"jobs"`
+	JobIDs            types.List              `tfsdk:"job_ids"`
+	JobStatuses       types.List              `tfsdk:"job_statuses"`
+	WaitForCompletion types.Bool              `tfsdk:"wait_for_completion"`
+	Triggers          types.Map               `tfsdk:"triggers"`
+}
+
+// BulkJobLaunchJobModel maps the job specifications of the bulk job launch resource schema data.
+type BulkJobLaunchJobModel struct {
+	TemplateID  types.Int64          `tfsdk:"job_template_id"`
+	InventoryID types.Int64          `tfsdk:"inventory_id"`
+	Limit       types.String         `tfsdk:"limit"`
+	ExtraVars   jsontypes.Normalized `tfsdk:"extra_vars"`
+}
+
+// BulkJobLaunchResource is the resource implementation.
+type BulkJobLaunchResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &BulkJobLaunchResource{}
+	_ resource.ResourceWithConfigure = &BulkJobLaunchResource{}
+)
+
+// NewBulkJobLaunchResource is a helper function to simplify the provider implementation.
+func NewBulkJobLaunchResource() resource.Resource {
+	return &BulkJobLaunchResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *BulkJobLaunchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_job_launch"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *BulkJobLaunchResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the bulk job launch resource.
+func (r *BulkJobLaunchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the workflow job created by the bulk job launch",
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Name of the workflow job created by the bulk job launch",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the workflow job created by the bulk job launch",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the workflow job created by the bulk job launch",
+			},
+			"inventory_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the inventory used by the jobs which do not provide their own inventory.",
+			},
+			"organization_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Identifier for the organization of the workflow job. " +
+					"Required when the user belongs to more than one organization.",
+			},
+			"jobs": schema.ListNestedAttribute{
+				Required:    true,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				Description: "The list of jobs to launch.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job_template_id": schema.Int64Attribute{
+							Required:    true,
+							Description: "Id of the job template.",
+						},
+						"inventory_id": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Identifier for the inventory where the job should be created in.",
+						},
+						"limit": schema.StringAttribute{
+							Optional:    true,
+							Description: "Host pattern limiting the hosts the job runs on.",
+						},
+						"extra_vars": schema.StringAttribute{
+							Optional:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "Extra Variables. Must be provided as a JSON string.",
+						},
+					},
+				},
+			},
+			"job_ids": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Description: "Ids of the launched jobs, in the same order as the jobs list.",
+			},
+			"job_statuses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Statuses of the launched jobs, in the same order as the jobs list.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, wait for all the launched jobs to finish. Defaults to false.",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Map of arbitrary keys and values that, when changed, will trigger a new bulk job launch on AAP." +
+					" Use 'terraform taint' if you want to force a new launch without changing this value.",
+			},
+		},
+	}
+}
+
+// Create launches the jobs and sets the Terraform state on success.
+func (r *BulkJobLaunchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BulkJobLaunchResourceModel
+
+	// Read Terraform plan data into bulk job launch resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.LaunchJobs(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state, before reporting the status so that the launched jobs are tracked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.CheckStatus()...)
+}
+
+// Read refreshes the Terraform state with the latest status of the launched jobs.
+func (r *BulkJobLaunchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BulkJobLaunchResourceModel
+
+	// Read current Terraform state data into bulk job launch resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadJobs(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update launches the jobs again when the launch configuration has changed.
+func (r *BulkJobLaunchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state BulkJobLaunchResourceModel
+
+	// Read Terraform plan and state data into bulk job launch resource models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relaunched := data.RequiresRelaunch(state)
+	if relaunched {
+		resp.Diagnostics.Append(r.LaunchJobs(&data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Only wait_for_completion changed, keep the launched jobs
+		data.ID = state.ID
+		data.URL = state.URL
+		data.Status = state.Status
+		data.JobIDs = state.JobIDs
+		data.JobStatuses = state.JobStatuses
+	}
+
+	// Save updated data into Terraform state, before reporting the status so that the launched jobs are tracked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if relaunched {
+		resp.Diagnostics.Append(data.CheckStatus()...)
+	}
+}
+
+// Delete does nothing, launched jobs are kept on AAP.
+func (r *BulkJobLaunchResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// CreateRequestBody creates a JSON encoded request body from the bulk job launch resource data
+func (r *BulkJobLaunchResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert bulk job launch resource data to API data model
+	bulkJobLaunch := BulkJobLaunchAPIModel{
+		Name:         r.Name.ValueString(),
+		Inventory:    r.InventoryID.ValueInt64(),
+		Organization: r.OrganizationID.ValueInt64(),
+		Jobs:         make([]BulkJobLaunchJobAPIModel, 0, len(r.Jobs)),
+	}
+	for _, job := range r.Jobs {
+		apiJob := BulkJobLaunchJobAPIModel{
+			TemplateID: job.TemplateID.ValueInt64(),
+			Inventory:  job.InventoryID.ValueInt64(),
+			Limit:      job.Limit.ValueString(),
+		}
+		if IsValueProvided(job.ExtraVars) {
+			apiJob.ExtraData = json.RawMessage(job.ExtraVars.ValueString())
+		}
+		bulkJobLaunch.Jobs = append(bulkJobLaunch.Jobs, apiJob)
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(bulkJobLaunch)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for bulk job launch resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the bulk job launch resource data from an AAP API response
+func (r *BulkJobLaunchResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var resultApiBulkJobLaunch BulkJobLaunchAPIModel
+	err := json.Unmarshal(body, &resultApiBulkJobLaunch)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the bulk job launch resource schema and update attribute values
+	r.ID = types.Int64Value(resultApiBulkJobLaunch.ID)
+	r.Name = types.StringValue(resultApiBulkJobLaunch.Name)
+	r.URL = types.StringValue(resultApiBulkJobLaunch.URL)
+	r.Status = types.StringValue(resultApiBulkJobLaunch.Status)
+
+	return diags
+}
+
+// ParseWorkflowNodes updates the ids and statuses of the launched jobs from the workflow job nodes returned by AAP.
+func (r *BulkJobLaunchResourceModel) ParseWorkflowNodes(results []json.RawMessage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nodes := make([]workflowJobNodeAPIModel, 0, len(results))
+	for _, result := range results {
+		var node workflowJobNodeAPIModel
+		err := json.Unmarshal(result, &node)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return diags
+		}
+		nodes = append(nodes, node)
+	}
+
+	// Nodes are created in the same order as the jobs list
+	slices.SortFunc(nodes, func(a, b workflowJobNodeAPIModel) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	jobIDs := make([]attr.Value, 0, len(nodes))
+	jobStatuses := make([]attr.Value, 0, len(nodes))
+	for _, node := range nodes {
+		// The job is not spawned until the workflow job runs the node
+		if node.Job == 0 {
+			jobIDs = append(jobIDs, types.Int64Null())
+			jobStatuses = append(jobStatuses, types.StringNull())
+			continue
+		}
+		jobIDs = append(jobIDs, types.Int64Value(node.Job))
+		jobStatuses = append(jobStatuses, ParseStringValue(node.SummaryFields.Job.Status))
+	}
+
+	var diagsList diag.Diagnostics
+	r.JobIDs, diagsList = types.ListValue(types.Int64Type, jobIDs)
+	diags.Append(diagsList...)
+	r.JobStatuses, diagsList = types.ListValue(types.StringType, jobStatuses)
+	diags.Append(diagsList...)
+
+	return diags
+}
+
+// RequiresRelaunch returns true when the configuration used to launch the jobs differs from the provided state.
+func (r *BulkJobLaunchResourceModel) RequiresRelaunch(state BulkJobLaunchResourceModel) bool {
+	return !r.Name.Equal(state.Name) ||
+		!r.InventoryID.Equal(state.InventoryID) ||
+		!r.OrganizationID.Equal(state.OrganizationID) ||
+		!slices.Equal(r.Jobs, state.Jobs) ||
+		!r.Triggers.Equal(state.Triggers)
+}
+
+// LaunchJobs launches the jobs through the bulk API, optionally waits for them to finish,
+// and saves the status of the launched jobs into the bulk job launch resource model.
+func (r *BulkJobLaunchResource) LaunchJobs(data *BulkJobLaunchResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Create request body from bulk job launch data
+	requestBody, diagCreateReq := data.CreateRequestBody()
+	diags.Append(diagCreateReq...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Launch the jobs in AAP
+	body, diagsCreate := r.client.Create("/api/v2/bulk/job_launch/", bytes.NewReader(requestBody))
+	diags.Append(diagsCreate...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.ParseHttpResponse(body)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if data.WaitForCompletion.ValueBool() {
+		_, diagsWait := waitForJob(r.client, data.URL.ValueString(), jobWaitTimeout)
+		diags.Append(diagsWait...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadJobs(data)...)
+	return diags
+}
+
+// CheckStatus returns an error when the workflow job was waited for and did not succeed.
+func (r *BulkJobLaunchResourceModel) CheckStatus() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.WaitForCompletion.ValueBool() && r.Status.ValueString() != jobStatusSuccessful {
+		diags.AddError(
+			"Bulk job launch did not succeed",
+			fmt.Sprintf("Workflow job %s finished with status %s", r.URL.ValueString(), r.Status.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// ReadJobs refreshes the bulk job launch resource model with the latest status of the workflow job and its jobs.
+func (r *BulkJobLaunchResource) ReadJobs(data *BulkJobLaunchResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get latest workflow job data from AAP
+	readResponseBody, diagsGet := r.client.Get(data.URL.ValueString())
+	diags.Append(diagsGet...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.ParseHttpResponse(readResponseBody)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	nodesURL, diagsURL := getURL(data.URL.ValueString(), "workflow_nodes")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	nodes, diagsNodes := getAllResults(r.client, nodesURL)
+	diags.Append(diagsNodes...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.ParseWorkflowNodes(nodes)...)
+	return diags
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBulkJobLaunchResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the BulkJobLaunchResource and call its Schema method
+	NewBulkJobLaunchResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestBulkJobLaunchResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    BulkJobLaunchResourceModel
+		expected []byte
+	}{
+		{
+			name: "null values",
+			input: BulkJobLaunchResourceModel{
+				Name:           types.StringUnknown(),
+				InventoryID:    types.Int64Null(),
+				OrganizationID: types.Int64Null(),
+				Jobs: []BulkJobLaunchJobModel{
+					{
+						TemplateID:  types.Int64Value(7),
+						InventoryID: types.Int64Null(),
+						Limit:       types.StringNull(),
+						ExtraVars:   jsontypes.NewNormalizedNull(),
+					},
+				},
+			},
+			expected: []byte(`{"jobs":[{"unified_job_template":7}]}`),
+		},
+		{
+			name: "all values",
+			input: BulkJobLaunchResourceModel{
+				Name:           types.StringValue("Provisioning"),
+				InventoryID:    types.Int64Value(2),
+				OrganizationID: types.Int64Value(1),
+				Jobs: []BulkJobLaunchJobModel{
+					{
+						TemplateID:  types.Int64Value(7),
+						InventoryID: types.Int64Value(3),
+						Limit:       types.StringValue("webservers"),
+						ExtraVars:   jsontypes.NewNormalizedValue(`{"state":"present"}`),
+					},
+					{
+						TemplateID:  types.Int64Value(8),
+						InventoryID: types.Int64Null(),
+						Limit:       types.StringNull(),
+						ExtraVars:   jsontypes.NewNormalizedNull(),
+					},
+				},
+			},
+			expected: []byte(`{"name":"Provisioning","inventory":2,"organization":1,"jobs":[` +
+				`{"unified_job_template":7,"inventory":3,"limit":"webservers","extra_data":{"state":"present"}},` +
+				`{"unified_job_template":8}]}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBulkJobLaunchResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected BulkJobLaunchResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: BulkJobLaunchResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "workflow job",
+			input: []byte(`{"id":12,"name":"Bulk Job Launch","url":"/api/v2/workflow_jobs/12/","status":"pending"}`),
+			expected: BulkJobLaunchResourceModel{
+				ID:     types.Int64Value(12),
+				Name:   types.StringValue("Bulk Job Launch"),
+				URL:    types.StringValue("/api/v2/workflow_jobs/12/"),
+				Status: types.StringValue("pending"),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := BulkJobLaunchResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestBulkJobLaunchResourceParseWorkflowNodes(t *testing.T) {
+	var testTable = []struct {
+		name             string
+		input            []json.RawMessage
+		expectedIDs      types.List
+		expectedStatuses types.List
+	}{
+		{
+			name:             "no nodes",
+			input:            []json.RawMessage{},
+			expectedIDs:      types.ListValueMust(types.Int64Type, []attr.Value{}),
+			expectedStatuses: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		{
+			name: "unordered nodes",
+			input: []json.RawMessage{
+				json.RawMessage(`{"id":31,"job":null,"summary_fields":{}}`),
+				json.RawMessage(`{"id":30,"job":41,"summary_fields":{"job":{"id":41,"status":"successful"}}}`),
+			},
+			expectedIDs:      types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(41), types.Int64Null()}),
+			expectedStatuses: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("successful"), types.StringNull()}),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := BulkJobLaunchResourceModel{}
+			diags := resource.ParseWorkflowNodes(test.input)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if !test.expectedIDs.Equal(resource.JobIDs) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expectedIDs, resource.JobIDs)
+			}
+			if !test.expectedStatuses.Equal(resource.JobStatuses) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expectedStatuses, resource.JobStatuses)
+			}
+		})
+	}
+}
+
+func TestBulkJobLaunchResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewBulkJobLaunchResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	var testTable = []struct {
+		name     string
+		status   string
+		expected []string
+	}{
+		{"successful workflow job", "successful", nil},
+		{"failed workflow job", "failed", []string{"Bulk job launch did not succeed"}},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			workflowJob := fmt.Sprintf(`{"id":10,"name":"bulk","url":"/api/v2/workflow_jobs/10/","status":"%s"}`, test.status)
+			r := BulkJobLaunchResource{client: NewMockRawHTTPClient(map[string]MockRawResponse{
+				"POST /api/v2/bulk/job_launch/": {
+					StatusCode: http.StatusCreated,
+					Body:       `{"id":10,"name":"bulk","url":"/api/v2/workflow_jobs/10/","status":"pending"}`,
+				},
+				"GET /api/v2/workflow_jobs/10/": {StatusCode: http.StatusOK, Body: workflowJob},
+				"GET /api/v2/workflow_jobs/10/workflow_nodes": {
+					StatusCode: http.StatusOK,
+					Body: fmt.Sprintf(`{"count":1,"next":null,"results":[{"id":30,"job":41,`+
+						`"summary_fields":{"job":{"id":41,"status":"%s"}}}]}`, test.status),
+				},
+			})}
+
+			plan := tfsdk.Plan{
+				Schema: schemaResponse.Schema,
+				Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := plan.Set(ctx, &BulkJobLaunchResourceModel{
+				ID:             types.Int64Unknown(),
+				Name:           types.StringValue("bulk"),
+				URL:            types.StringUnknown(),
+				Status:         types.StringUnknown(),
+				InventoryID:    types.Int64Null(),
+				OrganizationID: types.Int64Null(),
+				Jobs: []BulkJobLaunchJobModel{{
+					TemplateID:  types.Int64Value(7),
+					InventoryID: types.Int64Null(),
+					Limit:       types.StringNull(),
+					ExtraVars:   jsontypes.NewNormalizedNull(),
+				}},
+				JobIDs:            types.ListUnknown(types.Int64Type),
+				JobStatuses:       types.ListUnknown(types.StringType),
+				WaitForCompletion: types.BoolValue(true),
+				Triggers:          types.MapNull(types.StringType),
+			})
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+
+			req := fwresource.CreateRequest{Plan: plan}
+			resp := &fwresource.CreateResponse{
+				State: tfsdk.State{
+					Schema: schemaResponse.Schema,
+					Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.Create(ctx, req, resp)
+
+			var actual []string
+			for _, err := range resp.Diagnostics.Errors() {
+				actual = append(actual, err.Summary())
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected errors (%v) not equal to actual (%v)", test.expected, actual)
+			}
+
+			// The launched jobs are saved into the state even when the workflow job did not succeed
+			var state BulkJobLaunchResourceModel
+			diags = resp.State.Get(ctx, &state)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if state.URL.ValueString() != "/api/v2/workflow_jobs/10/" || state.Status.ValueString() != test.status {
+				t.Errorf("Expected launched workflow job (/api/v2/workflow_jobs/10/, %s), actual was (%s, %s)",
+					test.status, state.URL.ValueString(), state.Status.ValueString())
+			}
+			expectedIDs := types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(41)})
+			if !expectedIDs.Equal(state.JobIDs) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", expectedIDs, state.JobIDs)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccBulkJobLaunchResource(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBulkJobLaunchResource(jobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aap_bulk_job_launch.test", "name", "Terraform bulk job launch"),
+					resource.TestMatchResourceAttr("aap_bulk_job_launch.test", "url", regexp.MustCompile("^/api/v2/workflow_jobs/[0-9]*/$")),
+					resource.TestCheckResourceAttr("aap_bulk_job_launch.test", "status", jobStatusSuccessful),
+					resource.TestCheckResourceAttr("aap_bulk_job_launch.test", "job_ids.#", "2"),
+					resource.TestCheckResourceAttr("aap_bulk_job_launch.test", "job_statuses.0", jobStatusSuccessful),
+					resource.TestCheckResourceAttr("aap_bulk_job_launch.test", "job_statuses.1", jobStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func testAccBulkJobLaunchResource(jobTemplateID string) string {
+	return fmt.Sprintf(`
+resource "aap_bulk_job_launch" "test" {
+  name                = "Terraform bulk job launch"
+  wait_for_completion = true
+  jobs = [
+    { job_template_id = %[1]s },
+    { job_template_id = %[1]s },
+  ]
+}
+`, jobTemplateID)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -46,7 +47,12 @@ func NewClient(host string, username *string, password *string, insecureSkipVeri
 }
 
 func (c *AAPClient) computeURLPath(path string) string {
+	// Keep the query string, if any, out of the joined path
+	path, query, _ := strings.Cut(path, "?")
 	fullPath, _ := url.JoinPath(c.HostURL, path, "/")
+	if query != "" {
+		fullPath += "?" + query
+	}
 	return fullPath
 }
 
@@ -31,3 +31,26 @@ func TestComputeURLPath(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeURLPathWithQuery(t *testing.T) {
+	testTable := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{name: "query", path: "/api/v2/organizations/?name=Default", expected: "https://localhost:8043/api/v2/organizations/?name=Default"},
+		{name: "no trailing slash", path: "api/v2/jobs?page=2", expected: "https://localhost:8043/api/v2/jobs/?page=2"},
+		{name: "escaped query", path: "/api/v2/teams/?name=my+team&page_size=200", expected: "https://localhost:8043/api/v2/teams/?name=my+team&page_size=200"},
+		{name: "empty query", path: "/api/v2/jobs/?", expected: "https://localhost:8043/api/v2/jobs/"},
+	}
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			client, err := NewClient("https://localhost:8043", nil, nil, true, 0)
+			if err != nil {
+				t.Fatalf(`Failed to create provider client %v`, err)
+			}
+			result := client.computeURLPath(tc.path)
+			assert.Equal(t, result, tc.expected, fmt.Sprintf("expected (%s), got (%s)", tc.expected, result))
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Credential input source AAP API model
+type CredentialInputSourceAPIModel struct {
+	Id               int64             `json:"id,omitempty"`
+	Url              string            `json:"url,omitempty"`
+	Description      string            `json:"description"`
+	TargetCredential int64             `json:"target_credential"`
+	SourceCredential int64             `json:"source_credential"`
+	InputFieldName   string            `json:"input_field_name"`
+	Metadata         map[string]string `json:"metadata"`
+}
+
+// CredentialInputSourceResourceModel maps the credential input source resource schema to a Go struct.
+type CredentialInputSourceResourceModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Url              types.String `tfsdk:"url"`
+	Description      types.String `tfsdk:"description"`
+	TargetCredential types.Int64  `tfsdk:"target_credential"`
+	SourceCredential types.Int64  `tfsdk:"source_credential"`
+	InputFieldName   types.String `tfsdk:"input_field_name"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+}
+
+// CredentialInputSourceResource is the resource implementation.
+type CredentialInputSourceResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &CredentialInputSourceResource{}
+	_ resource.ResourceWithConfigure = &CredentialInputSourceResource{}
+)
+
+// NewCredentialInputSourceResource is a helper function to simplify the provider implementation.
+func NewCredentialInputSourceResource() resource.Resource {
+	return &CredentialInputSourceResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *CredentialInputSourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_input_source"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CredentialInputSourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the credential input source resource.
+func (r *CredentialInputSourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Credential input source id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the credential input source",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the credential input source",
+			},
+			"target_credential": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the credential whose input field is looked up. Changing it creates a new credential input source.",
+			},
+			"input_field_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Id of the input field of the target credential, such as password or ssh_key_data. " +
+					"Changing it creates a new credential input source.",
+			},
+			"source_credential": schema.Int64Attribute{
+				Required:    true,
+				Description: "Identifier for the external secret management credential, such as a HashiCorp Vault or CyberArk credential, used for the lookup.",
+			},
+			"metadata": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Lookup parameters defined by the credential type of the source credential, such as secret_path and secret_key.",
+			},
+		},
+	}
+}
+
+// Create creates the credential input source resource and sets the Terraform state on success.
+func (r *CredentialInputSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredentialInputSourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into credential input source resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from credential input source data
+	createRequestBody, diags := data.CreateRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new credential input source in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/credential_input_sources/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new credential input source data into credential input source resource model
+	diags = data.ParseHttpResponse(ctx, createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest credential input source data.
+func (r *CredentialInputSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredentialInputSourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into credential input source resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest credential input source data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest credential input source data into credential input source resource model
+	diags = data.ParseHttpResponse(ctx, readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the credential input source resource and sets the updated Terraform state on success.
+func (r *CredentialInputSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredentialInputSourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into credential input source resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from credential input source data
+	updateRequestBody, diags := data.CreateRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update credential input source in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated credential input source data into credential input source resource model
+	diags = data.ParseHttpResponse(ctx, updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the credential input source resource.
+func (r *CredentialInputSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredentialInputSourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into credential input source resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete credential input source from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the credential input source resource data
+func (r *CredentialInputSourceResourceModel) CreateRequestBody(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert credential input source resource data to API data model
+	inputSource := CredentialInputSourceAPIModel{
+		Description:      r.Description.ValueString(),
+		TargetCredential: r.TargetCredential.ValueInt64(),
+		SourceCredential: r.SourceCredential.ValueInt64(),
+		InputFieldName:   r.InputFieldName.ValueString(),
+		Metadata:         map[string]string{},
+	}
+	if IsValueProvided(r.Metadata) {
+		diags.Append(r.Metadata.ElementsAs(ctx, &inputSource.Metadata, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(inputSource)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for credential input source resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the credential input source resource data from an AAP API response
+func (r *CredentialInputSourceResourceModel) ParseHttpResponse(ctx context.Context, body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiInputSource CredentialInputSourceAPIModel
+	err := json.Unmarshal(body, &apiInputSource)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the credential input source resource schema and update attribute values
+	r.Id = types.Int64Value(apiInputSource.Id)
+	r.Url = types.StringValue(apiInputSource.Url)
+	r.Description = ParseStringValue(apiInputSource.Description)
+	r.TargetCredential = types.Int64Value(apiInputSource.TargetCredential)
+	r.SourceCredential = types.Int64Value(apiInputSource.SourceCredential)
+	r.InputFieldName = types.StringValue(apiInputSource.InputFieldName)
+
+	// Keep metadata unset when none is configured nor stored in AAP
+	if len(apiInputSource.Metadata) > 0 || !r.Metadata.IsNull() {
+		var diagsMetadata diag.Diagnostics
+		r.Metadata, diagsMetadata = types.MapValueFrom(ctx, types.StringType, apiInputSource.Metadata)
+		diags.Append(diagsMetadata...)
+	}
+
+	return diags
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCredentialInputSourceResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the CredentialInputSourceResource and call its Schema method
+	NewCredentialInputSourceResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestCredentialInputSourceResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    CredentialInputSourceResourceModel
+		expected []byte
+	}{
+		{
+			name: "null values",
+			input: CredentialInputSourceResourceModel{
+				Description:      types.StringNull(),
+				TargetCredential: types.Int64Value(1),
+				SourceCredential: types.Int64Value(2),
+				InputFieldName:   types.StringValue("password"),
+				Metadata:         types.MapNull(types.StringType),
+			},
+			expected: []byte(`{"description":"","target_credential":1,"source_credential":2,"input_field_name":"password","metadata":{}}`),
+		},
+		{
+			name: "all values",
+			input: CredentialInputSourceResourceModel{
+				Description:      types.StringValue("Machine password from Vault"),
+				TargetCredential: types.Int64Value(1),
+				SourceCredential: types.Int64Value(2),
+				InputFieldName:   types.StringValue("password"),
+				Metadata: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"secret_path": types.StringValue("/kv/machine"),
+					"secret_key":  types.StringValue("password"),
+				}),
+			},
+			expected: []byte(`{"description":"Machine password from Vault","target_credential":1,"source_credential":2,` +
+				`"input_field_name":"password","metadata":{"secret_key":"password","secret_path":"/kv/machine"}}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody(context.Background())
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCredentialInputSourceResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected CredentialInputSourceResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: CredentialInputSourceResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "no metadata",
+			input: []byte(`{"id":5,"url":"/api/v2/credential_input_sources/5/","description":"","target_credential":1,` +
+				`"source_credential":2,"input_field_name":"password","metadata":{}}`),
+			expected: CredentialInputSourceResourceModel{
+				Id:               types.Int64Value(5),
+				Url:              types.StringValue("/api/v2/credential_input_sources/5/"),
+				Description:      types.StringNull(),
+				TargetCredential: types.Int64Value(1),
+				SourceCredential: types.Int64Value(2),
+				InputFieldName:   types.StringValue("password"),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "metadata",
+			input: []byte(`{"id":5,"url":"/api/v2/credential_input_sources/5/","description":"From Vault","target_credential":1,` +
+				`"source_credential":2,"input_field_name":"password","metadata":{"secret_path":"/kv/machine","secret_key":"password"}}`),
+			expected: CredentialInputSourceResourceModel{
+				Id:               types.Int64Value(5),
+				Url:              types.StringValue("/api/v2/credential_input_sources/5/"),
+				Description:      types.StringValue("From Vault"),
+				TargetCredential: types.Int64Value(1),
+				SourceCredential: types.Int64Value(2),
+				InputFieldName:   types.StringValue("password"),
+				Metadata: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"secret_path": types.StringValue("/kv/machine"),
+					"secret_key":  types.StringValue("password"),
+				}),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := CredentialInputSourceResourceModel{}
+			diags := resource.ParseHttpResponse(context.Background(), test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func testAccCredentialInputSourceResourcePreCheck(t *testing.T) {
+	// Ensure provider requirements
+	testAccPreCheck(t)
+
+	requiredAAPCredentialInputSourceEnvVars := []string{
+		"AAP_TEST_MACHINE_CREDENTIAL_TYPE_ID",
+		"AAP_TEST_LOOKUP_CREDENTIAL_ID",
+	}
+
+	for _, key := range requiredAAPCredentialInputSourceEnvVars {
+		if v := os.Getenv(key); v == "" {
+			t.Fatalf("'%s' environment variable must be set when running acceptance tests for credential input source resource", key)
+		}
+	}
+}
+
+func TestAccCredentialInputSourceResource(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	credentialTypeID := os.Getenv("AAP_TEST_MACHINE_CREDENTIAL_TYPE_ID")
+	lookupCredentialID := os.Getenv("AAP_TEST_LOOKUP_CREDENTIAL_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccCredentialInputSourceResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCredentialInputSourceResource(randomName, credentialTypeID, lookupCredentialID, "password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("aap_credential_input_source.test", "target_credential", "aap_credential.test", "id"),
+					resource.TestCheckResourceAttr("aap_credential_input_source.test", "source_credential", lookupCredentialID),
+					resource.TestCheckResourceAttr("aap_credential_input_source.test", "input_field_name", "password"),
+					resource.TestCheckResourceAttr("aap_credential_input_source.test", "metadata.secret_key", "password"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCredentialInputSourceResource(randomName, credentialTypeID, lookupCredentialID, "machine_password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aap_credential_input_source.test", "metadata.secret_key", "machine_password"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckCredentialInputSourceResourceDestroy,
+	})
+}
+
+// testAccCredentialInputSourceResource returns a configuration for a Machine Credential whose password is looked up.
+func testAccCredentialInputSourceResource(name string, credentialTypeID string, lookupCredentialID string, secretKey string) string {
+	return fmt.Sprintf(`
+resource "aap_credential" "test" {
+  name               = "%s"
+  credential_type_id = %s
+  organization       = 1
+  inputs = {
+    username = "admin"
+  }
+}
+
+resource "aap_credential_input_source" "test" {
+  target_credential = aap_credential.test.id
+  input_field_name  = "password"
+  source_credential = %s
+  metadata = {
+    secret_path = "/kv/terraform"
+    secret_key  = "%s"
+  }
+}`, name, credentialTypeID, lookupCredentialID, secretKey)
+}
+
+// testAccCheckCredentialInputSourceResourceDestroy verifies the credential input source has been destroyed.
+func testAccCheckCredentialInputSourceResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_credential_input_source" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("credential input source (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,490 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// encryptedValue is returned by AAP in place of the value of secret fields.
+const encryptedValue = "$encrypted$"
+
+// credentialInputsHashSaltSize is the size in bytes of the random salt of the inputs hash.
+const credentialInputsHashSaltSize = 16
+
+// Credential AAP API model
+type CredentialAPIModel struct {
+	Id             int64                  `json:"id,omitempty"`
+	Url            string                 `json:"url,omitempty"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	CredentialType int64                  `json:"credential_type"`
+	Organization   *int64                 `json:"organization"`
+	User           *int64                 `json:"user,omitempty"`
+	Team           *int64                 `json:"team,omitempty"`
+	Inputs         map[string]interface{} `json:"inputs"`
+}
+
+// CredentialResourceModel maps the credential resource schema to a Go struct.
+type CredentialResourceModel struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Url              types.String `tfsdk:"url"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	CredentialTypeId types.Int64  `tfsdk:"credential_type_id"`
+	Organization     types.Int64  `tfsdk:"organization"`
+	User             types.Int64  `tfsdk:"user"`
+	Team             types.Int64  `tfsdk:"team"`
+	Inputs           types.Map    `tfsdk:"inputs"`
+	InputsHash       types.String `tfsdk:"inputs_hash"`
+}
+
+// CredentialResource is the resource implementation.
+type CredentialResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &CredentialResource{}
+	_ resource.ResourceWithConfigure = &CredentialResource{}
+)
+
+// NewCredentialResource is a helper function to simplify the provider implementation.
+func NewCredentialResource() resource.Resource {
+	return &CredentialResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *CredentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the credential resource.
+func (r *CredentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Credential id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the credential",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the credential",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the credential",
+			},
+			"credential_type_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the type of the credential, such as Machine, Source Control or Vault.",
+			},
+			"organization": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the organization owning the credential.",
+			},
+			"user": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("team")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the user owning the credential. Changing it creates a new credential.",
+			},
+			"team": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the team owning the credential. Changing it creates a new credential.",
+			},
+			"inputs": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Values of the fields defined by the credential type, such as username, password or ssh_key_data. " +
+					"Values of boolean fields must be true or false. AAP never returns the value of secret fields, so " +
+					"changes made to them outside of Terraform are not detected.",
+			},
+			"inputs_hash": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "Salted hash of the inputs last applied by Terraform: a random salt and the HMAC-SHA256 of the " +
+					"inputs keyed with the salt, separated by a colon.",
+			},
+		},
+	}
+}
+
+// Create creates the credential resource and sets the Terraform state on success.
+func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredentialResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into credential resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from credential data, with the input values typed as the credential type fields
+	fieldTypes, diags := r.credentialTypeFieldTypes(data.CredentialTypeId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createRequestBody, diags := data.CreateRequestBody(ctx, fieldTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new credential in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/credentials/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new credential data into credential resource model
+	resp.Diagnostics.Append(data.SetInputsHash(ctx)...)
+	diags = data.ParseHttpResponse(ctx, createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest credential data.
+func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredentialResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into credential resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest credential data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest credential data into credential resource model
+	diags = data.ParseHttpResponse(ctx, readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the credential resource and sets the updated Terraform state on success.
+func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredentialResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into credential resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from credential data, with the input values typed as the credential type fields
+	fieldTypes, diags := r.credentialTypeFieldTypes(data.CredentialTypeId.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateRequestBody, diags := data.CreateRequestBody(ctx, fieldTypes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update credential in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated credential data into credential resource model
+	resp.Diagnostics.Append(data.SetInputsHash(ctx)...)
+	diags = data.ParseHttpResponse(ctx, updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the credential resource.
+func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredentialResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into credential resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete credential from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// credentialTypeFieldTypes returns the types of the input fields of the credential type, such as string or boolean,
+// by field id.
+func (r *CredentialResource) credentialTypeFieldTypes(credentialTypeID int64) (map[string]string, diag.Diagnostics) {
+	body, diags := r.client.Get(fmt.Sprintf("/api/v2/credential_types/%d/", credentialTypeID))
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var credentialType CredentialTypeAPIModel
+	var inputs CredentialTypeInputsAPIModel
+	err := json.Unmarshal(body, &credentialType)
+	if err == nil && len(credentialType.Inputs) > 0 {
+		err = json.Unmarshal(credentialType.Inputs, &inputs)
+	}
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return nil, diags
+	}
+
+	fieldTypes := make(map[string]string, len(inputs.Fields))
+	for _, field := range inputs.Fields {
+		fieldTypes[field.Id] = field.Type
+	}
+	return fieldTypes, diags
+}
+
+// CreateRequestBody creates a JSON encoded request body from the credential resource data.
+// Inputs of boolean fields, according to the provided field types, are sent as booleans.
+func (r *CredentialResourceModel) CreateRequestBody(ctx context.Context, fieldTypes map[string]string) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	inputs := map[string]string{}
+	diags.Append(r.Inputs.ElementsAs(ctx, &inputs, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Convert credential resource data to API data model
+	credential := CredentialAPIModel{
+		Name:           r.Name.ValueString(),
+		Description:    r.Description.ValueString(),
+		CredentialType: r.CredentialTypeId.ValueInt64(),
+		Organization:   r.Organization.ValueInt64Pointer(),
+		User:           r.User.ValueInt64Pointer(),
+		Team:           r.Team.ValueInt64Pointer(),
+		Inputs:         map[string]interface{}{},
+	}
+	for key, value := range inputs {
+		if fieldTypes[key] != "boolean" {
+			credential.Inputs[key] = value
+			continue
+		}
+		if value != "true" && value != "false" {
+			diags.AddAttributeError(
+				path.Root("inputs").AtMapKey(key),
+				"Invalid credential input",
+				fmt.Sprintf("The %s input is a boolean field, expected true or false, got: %s", key, value),
+			)
+			continue
+		}
+		credential.Inputs[key] = value == "true"
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(credential)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for credential resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the credential resource data from an AAP API response.
+// Secret inputs returned as $encrypted$ keep their last-applied value, as long as the inputs hash proves that the
+// current inputs were applied by Terraform.
+func (r *CredentialResourceModel) ParseHttpResponse(ctx context.Context, body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiCredential CredentialAPIModel
+	err := json.Unmarshal(body, &apiCredential)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	appliedInputs := map[string]string{}
+	if !r.Inputs.IsNull() && !r.Inputs.IsUnknown() {
+		diags.Append(r.Inputs.ElementsAs(ctx, &appliedInputs, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	trustApplied := credentialInputsHashMatches(r.InputsHash.ValueString(), appliedInputs)
+
+	inputs := make(map[string]string, len(apiCredential.Inputs))
+	for key, value := range apiCredential.Inputs {
+		stringValue, ok := value.(string)
+		if !ok {
+			jsonValue, _ := json.Marshal(value)
+			stringValue = string(jsonValue)
+		}
+		if applied, found := appliedInputs[key]; stringValue == encryptedValue && trustApplied && found {
+			stringValue = applied
+		}
+		inputs[key] = stringValue
+	}
+
+	// Map response to the credential resource schema and update attribute values
+	r.Id = types.Int64Value(apiCredential.Id)
+	r.Url = types.StringValue(apiCredential.Url)
+	r.Name = types.StringValue(apiCredential.Name)
+	r.Description = ParseStringValue(apiCredential.Description)
+	r.CredentialTypeId = types.Int64Value(apiCredential.CredentialType)
+	r.Organization = types.Int64PointerValue(apiCredential.Organization)
+
+	// Keep inputs unset when none are configured nor stored in AAP
+	if len(inputs) > 0 || !r.Inputs.IsNull() {
+		var diagsInputs diag.Diagnostics
+		r.Inputs, diagsInputs = types.MapValueFrom(ctx, types.StringType, inputs)
+		diags.Append(diagsInputs...)
+	}
+
+	return diags
+}
+
+// SetInputsHash stores the salted hash of the inputs about to be applied into the credential resource model,
+// with a new random salt.
+func (r *CredentialResourceModel) SetInputsHash(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inputs := map[string]string{}
+	diags.Append(r.Inputs.ElementsAs(ctx, &inputs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	salt := make([]byte, credentialInputsHashSaltSize)
+	_, err := rand.Read(salt)
+	if err != nil {
+		diags.AddError("Error generating the salt of the inputs hash", err.Error())
+		return diags
+	}
+
+	r.InputsHash = types.StringValue(hashCredentialInputs(salt, inputs))
+	return diags
+}
+
+// hashCredentialInputs returns the hex encoded salt and the hex encoded HMAC-SHA256 of the JSON encoded inputs
+// keyed with the salt, separated by a colon.
+func hashCredentialInputs(salt []byte, inputs map[string]string) string {
+	// Maps are encoded with sorted keys, the encoding of equal inputs is always the same
+	jsonInputs, _ := json.Marshal(inputs)
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(jsonInputs)
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// credentialInputsHashMatches returns true when the salted hash was computed from the inputs.
+func credentialInputsHashMatches(hash string, inputs map[string]string) bool {
+	encodedSalt, _, found := strings.Cut(hash, ":")
+	if !found {
+		return false
+	}
+	salt, err := hex.DecodeString(encodedSalt)
+	if err != nil || len(salt) == 0 {
+		return false
+	}
+	return hmac.Equal([]byte(hash), []byte(hashCredentialInputs(salt, inputs)))
+}
@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCredentialResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the CredentialResource and call its Schema method
+	NewCredentialResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestCredentialResourceCreateRequestBody(t *testing.T) {
+	fieldTypes := map[string]string{"username": "string", "password": "string", "verify_ssl": "boolean"}
+
+	var testTable = []struct {
+		name     string
+		input    CredentialResourceModel
+		expected []byte
+	}{
+		{
+			name: "null values",
+			input: CredentialResourceModel{
+				Name:             types.StringValue("test credential"),
+				Description:      types.StringNull(),
+				CredentialTypeId: types.Int64Value(1),
+				Organization:     types.Int64Null(),
+				User:             types.Int64Null(),
+				Team:             types.Int64Null(),
+				Inputs:           types.MapNull(types.StringType),
+			},
+			expected: []byte(`{"name":"test credential","description":"","credential_type":1,"organization":null,"inputs":{}}`),
+		},
+		{
+			name: "all values",
+			input: CredentialResourceModel{
+				Name:             types.StringValue("test credential"),
+				Description:      types.StringValue("A test credential"),
+				CredentialTypeId: types.Int64Value(1),
+				Organization:     types.Int64Value(2),
+				User:             types.Int64Null(),
+				Team:             types.Int64Value(3),
+				Inputs: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"username": types.StringValue("admin"),
+					"password": types.StringValue("secret"),
+				}),
+			},
+			expected: []byte(`{"name":"test credential","description":"A test credential","credential_type":1,"organization":2,` +
+				`"team":3,"inputs":{"password":"secret","username":"admin"}}`),
+		},
+		{
+			name: "boolean field",
+			input: CredentialResourceModel{
+				Name:             types.StringValue("test credential"),
+				Description:      types.StringNull(),
+				CredentialTypeId: types.Int64Value(1),
+				Organization:     types.Int64Null(),
+				User:             types.Int64Null(),
+				Team:             types.Int64Null(),
+				Inputs: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"username":   types.StringValue("true"),
+					"verify_ssl": types.StringValue("false"),
+				}),
+			},
+			expected: []byte(`{"name":"test credential","description":"","credential_type":1,"organization":null,` +
+				`"inputs":{"username":"true","verify_ssl":false}}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody(context.Background(), fieldTypes)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCredentialResourceCreateRequestBodyInvalidBoolean(t *testing.T) {
+	input := CredentialResourceModel{
+		Name:             types.StringValue("test credential"),
+		CredentialTypeId: types.Int64Value(1),
+		Inputs: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"verify_ssl": types.StringValue("yes"),
+		}),
+	}
+
+	_, diags := input.CreateRequestBody(context.Background(), map[string]string{"verify_ssl": "boolean"})
+	expected := []string{"The verify_ssl input is a boolean field, expected true or false, got: yes"}
+	var actual []string
+	for _, err := range diags.Errors() {
+		actual = append(actual, err.Detail())
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected errors (%v) not equal to actual (%v)", expected, actual)
+	}
+}
+
+func TestCredentialResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	appliedInputs := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"username": types.StringValue("admin"),
+		"password": types.StringValue("secret"),
+	})
+	appliedHash := hashCredentialInputs([]byte("salt"), map[string]string{"username": "admin", "password": "secret"})
+	response := []byte(`{"id":4,"url":"/api/v2/credentials/4/","name":"test credential","description":"",` +
+		`"credential_type":1,"organization":null,"inputs":{"username":"root","password":"$encrypted$","become_method":"sudo"}}`)
+
+	var testTable = []struct {
+		name     string
+		state    CredentialResourceModel
+		input    []byte
+		expected CredentialResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			state:    CredentialResourceModel{},
+			input:    []byte("Not valid JSON"),
+			expected: CredentialResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "no inputs",
+			state: CredentialResourceModel{Inputs: types.MapNull(types.StringType)},
+			input: []byte(`{"id":4,"url":"/api/v2/credentials/4/","name":"test credential","description":"A test credential",` +
+				`"credential_type":1,"organization":2,"inputs":{}}`),
+			expected: CredentialResourceModel{
+				Id:               types.Int64Value(4),
+				Url:              types.StringValue("/api/v2/credentials/4/"),
+				Name:             types.StringValue("test credential"),
+				Description:      types.StringValue("A test credential"),
+				CredentialTypeId: types.Int64Value(1),
+				Organization:     types.Int64Value(2),
+				Inputs:           types.MapNull(types.StringType),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name:  "applied secrets are kept",
+			state: CredentialResourceModel{Inputs: appliedInputs, InputsHash: types.StringValue(appliedHash)},
+			input: response,
+			expected: CredentialResourceModel{
+				Id:               types.Int64Value(4),
+				Url:              types.StringValue("/api/v2/credentials/4/"),
+				Name:             types.StringValue("test credential"),
+				Description:      types.StringNull(),
+				CredentialTypeId: types.Int64Value(1),
+				Organization:     types.Int64Null(),
+				Inputs: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"username":      types.StringValue("root"),
+					"password":      types.StringValue("secret"),
+					"become_method": types.StringValue("sudo"),
+				}),
+				InputsHash: types.StringValue(appliedHash),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name:  "unknown secrets stay encrypted",
+			state: CredentialResourceModel{Inputs: appliedInputs, InputsHash: types.StringValue("not the applied hash")},
+			input: response,
+			expected: CredentialResourceModel{
+				Id:               types.Int64Value(4),
+				Url:              types.StringValue("/api/v2/credentials/4/"),
+				Name:             types.StringValue("test credential"),
+				Description:      types.StringNull(),
+				CredentialTypeId: types.Int64Value(1),
+				Organization:     types.Int64Null(),
+				Inputs: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"username":      types.StringValue("root"),
+					"password":      types.StringValue("$encrypted$"),
+					"become_method": types.StringValue("sudo"),
+				}),
+				InputsHash: types.StringValue("not the applied hash"),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := test.state
+			diags := resource.ParseHttpResponse(context.Background(), test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if test.errors.HasError() {
+				return
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestHashCredentialInputs(t *testing.T) {
+	inputs := map[string]string{"username": "admin", "password": "secret"}
+	first := hashCredentialInputs([]byte("salt"), inputs)
+	second := hashCredentialInputs([]byte("salt"), map[string]string{"password": "secret", "username": "admin"})
+	resalted := hashCredentialInputs([]byte("other salt"), inputs)
+	unsalted := sha256.Sum256([]byte(`{"password":"secret","username":"admin"}`))
+
+	if first != second {
+		t.Errorf("Expected equal inputs to have the same hash, got (%s) and (%s)", first, second)
+	}
+	if first == resalted {
+		t.Errorf("Expected a different salt to change the hash (%s)", first)
+	}
+
+	var testTable = []struct {
+		name     string
+		hash     string
+		inputs   map[string]string
+		expected bool
+	}{
+		{"applied inputs", first, inputs, true},
+		{"applied inputs with another salt", resalted, inputs, true},
+		{"changed secret", first, map[string]string{"username": "admin", "password": "changed"}, false},
+		{"unsalted hash", hex.EncodeToString(unsalted[:]), inputs, false},
+		{"invalid salt", "not hex:" + strings.SplitN(first, ":", 2)[1], inputs, false},
+		{"no hash", "", inputs, false},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := credentialInputsHashMatches(test.hash, test.inputs)
+			if actual != test.expected {
+				t.Errorf("Expected (%t) not equal to actual (%t)", test.expected, actual)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func testAccCredentialResourcePreCheck(t *testing.T) {
+	// Ensure provider requirements
+	testAccPreCheck(t)
+
+	if v := os.Getenv("AAP_TEST_MACHINE_CREDENTIAL_TYPE_ID"); v == "" {
+		t.Fatal("'AAP_TEST_MACHINE_CREDENTIAL_TYPE_ID' environment variable must be set when running acceptance tests for credential resource")
+	}
+}
+
+func TestAccCredentialResource(t *testing.T) {
+	var credential CredentialAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	credentialTypeID := os.Getenv("AAP_TEST_MACHINE_CREDENTIAL_TYPE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccCredentialResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCredentialResource(randomName, credentialTypeID, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCredentialResourceExists("aap_credential.test", &credential),
+					testAccCheckCredentialResourceEncrypted(&credential, "password"),
+					resource.TestCheckResourceAttr("aap_credential.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_credential.test", "inputs.username", "admin"),
+					resource.TestCheckResourceAttr("aap_credential.test", "inputs.password", "first"),
+					resource.TestCheckResourceAttrSet("aap_credential.test", "inputs_hash"),
+				),
+			},
+			// Changing only the secret is detected and applied
+			{
+				Config: testAccCredentialResource(randomName, credentialTypeID, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCredentialResourceExists("aap_credential.test", &credential),
+					resource.TestCheckResourceAttr("aap_credential.test", "inputs.password", "second"),
+				),
+			},
+			// Re-applying the same configuration shows no changes
+			{
+				Config:   testAccCredentialResource(randomName, credentialTypeID, "second"),
+				PlanOnly: true,
+			},
+		},
+		CheckDestroy: testAccCheckCredentialResourceDestroy,
+	})
+}
+
+// testAccCredentialResource returns a configuration for an AAP Credential with the provided password.
+func testAccCredentialResource(name string, credentialTypeID string, password string) string {
+	return fmt.Sprintf(`
+resource "aap_credential" "test" {
+  name               = "%s"
+  credential_type_id = %s
+  organization       = 1
+  inputs = {
+    username = "admin"
+    password = "%s"
+  }
+}`, name, credentialTypeID, password)
+}
+
+// testAccCheckCredentialResourceExists queries the AAP API and retrieves the matching credential.
+func testAccCheckCredentialResourceExists(name string, credential *CredentialAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		credentialResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("credential (%s) not found in state", name)
+		}
+
+		credentialResponseBody, err := testGetResource(credentialResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(credentialResponseBody, &credential)
+		if err != nil {
+			return err
+		}
+
+		if credential.Id == 0 {
+			return fmt.Errorf("credential (%s) not found in AAP", credentialResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckCredentialResourceEncrypted verifies that AAP does not return the value of the provided secret input.
+func testAccCheckCredentialResourceEncrypted(credential *CredentialAPIModel, input string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if credential.Inputs[input] != encryptedValue {
+			return fmt.Errorf("bad credential %s input in AAP, expected \"%s\", got: %v", input, encryptedValue, credential.Inputs[input])
+		}
+		return nil
+	}
+}
+
+// testAccCheckCredentialResourceDestroy verifies the credential has been destroyed.
+func testAccCheckCredentialResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_credential" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("credential (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// injectorVariableRegex matches the first variable of each template expression used in the injectors.
+var injectorVariableRegex = regexp.MustCompile(`{{-?\s*([A-Za-z_][A-Za-z0-9_]*)`)
+
+// injectorBuiltinVariables are the variables provided by AAP to the injector templates.
+var injectorBuiltinVariables = []string{"tower", "awx"}
+
+// Credential type AAP API model
+type CredentialTypeAPIModel struct {
+	Id          int64           `json:"id,omitempty"`
+	Url         string          `json:"url,omitempty"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Kind        string          `json:"kind"`
+	Inputs      json.RawMessage `json:"inputs"`
+	Injectors   json.RawMessage `json:"injectors"`
+}
+
+// Credential type inputs AAP API model
+type CredentialTypeInputsAPIModel struct {
+	Fields []struct {
+		Id   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"fields"`
+}
+
+// CredentialTypeResourceModel maps the credential type resource schema to a Go struct.
+type CredentialTypeResourceModel struct {
+	Id          types.Int64                      `tfsdk:"id"`
+	Url         types.String                     `tfsdk:"url"`
+	Name        types.String                     `tfsdk:"name"`
+	Description types.String                     `tfsdk:"description"`
+	Kind        types.String                     `tfsdk:"kind"`
+	Inputs      customtypes.AAPCustomStringValue `tfsdk:"inputs"`
+	Injectors   customtypes.AAPCustomStringValue `tfsdk:"injectors"`
+}
+
+// CredentialTypeResource is the resource implementation.
+type CredentialTypeResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &CredentialTypeResource{}
+	_ resource.ResourceWithConfigure      = &CredentialTypeResource{}
+	_ resource.ResourceWithValidateConfig = &CredentialTypeResource{}
+)
+
+// NewCredentialTypeResource is a helper function to simplify the provider implementation.
+func NewCredentialTypeResource() resource.Resource {
+	return &CredentialTypeResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *CredentialTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_type"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CredentialTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the credential type resource.
+func (r *CredentialTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Credential type id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the credential type",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the credential type",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the credential type",
+			},
+			"kind": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("cloud"),
+				Validators:  []validator.String{stringvalidator.OneOf("cloud", "net")},
+				Description: "Kind of the credential type, either cloud or net. Defaults to cloud.",
+			},
+			"inputs": schema.StringAttribute{
+				Optional:   true,
+				CustomType: customtypes.AAPCustomStringType{},
+				Description: "Input configuration of the credential type, defining the fields of the credentials. " +
+					"Must be provided as either a JSON or YAML string.",
+			},
+			"injectors": schema.StringAttribute{
+				Optional:   true,
+				CustomType: customtypes.AAPCustomStringType{},
+				Description: "Injector configuration of the credential type, defining how the fields are provided to jobs. " +
+					"Must be provided as either a JSON or YAML string. Templates may only reference the ids of the declared input fields.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the injector templates only reference declared input fields.
+func (r *CredentialTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CredentialTypeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Inputs.IsUnknown() || data.Injectors.IsUnknown() {
+		return
+	}
+
+	inputIDs, diags := parseCredentialTypeInputIDs(data.Inputs.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	injectors, diags := decodeCredentialTypeConfiguration("injectors", data.Injectors.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, variable := range injectorTemplateVariables(injectors) {
+		if !inputIDs[variable] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("injectors"),
+				"Undeclared injector template variable",
+				fmt.Sprintf("The injectors reference %q, which is not the id of a field declared in the inputs.", variable),
+			)
+		}
+	}
+}
+
+// Create creates the credential type resource and sets the Terraform state on success.
+func (r *CredentialTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredentialTypeResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into credential type resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from credential type data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new credential type in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/credential_types/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new credential type data into credential type resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest credential type data.
+func (r *CredentialTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredentialTypeResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into credential type resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest credential type data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest credential type data into credential type resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the credential type resource and sets the updated Terraform state on success.
+func (r *CredentialTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredentialTypeResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into credential type resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from credential type data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update credential type in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated credential type data into credential type resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the credential type resource.
+func (r *CredentialTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredentialTypeResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into credential type resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete credential type from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the credential type resource data
+func (r *CredentialTypeResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	inputs, diagsInputs := decodeCredentialTypeConfiguration("inputs", r.Inputs.ValueString())
+	diags.Append(diagsInputs...)
+	injectors, diagsInjectors := decodeCredentialTypeConfiguration("injectors", r.Injectors.ValueString())
+	diags.Append(diagsInjectors...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Convert credential type resource data to API data model
+	credentialType := map[string]interface{}{
+		"name":        r.Name.ValueString(),
+		"description": r.Description.ValueString(),
+		"kind":        r.Kind.ValueString(),
+		"inputs":      inputs,
+		"injectors":   injectors,
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(credentialType)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for credential type resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the credential type resource data from an AAP API response.
+// The inputs and injectors keep their current representation when it is equivalent to the one returned by AAP.
+func (r *CredentialTypeResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiCredentialType CredentialTypeAPIModel
+	err := json.Unmarshal(body, &apiCredentialType)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the credential type resource schema and update attribute values
+	r.Id = types.Int64Value(apiCredentialType.Id)
+	r.Url = types.StringValue(apiCredentialType.Url)
+	r.Name = types.StringValue(apiCredentialType.Name)
+	r.Description = ParseStringValue(apiCredentialType.Description)
+	r.Kind = types.StringValue(apiCredentialType.Kind)
+	r.Inputs = parseJSONObjectValue(r.Inputs, apiCredentialType.Inputs)
+	r.Injectors = parseJSONObjectValue(r.Injectors, apiCredentialType.Injectors)
+
+	return diags
+}
+
+// decodeCredentialTypeConfiguration decodes the JSON or YAML configuration of a credential type.
+func decodeCredentialTypeConfiguration(attribute string, value string) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, err := decodeJSONOrYAML(value)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid credential type configuration",
+			fmt.Sprintf("The %s must be provided as either a JSON or YAML string: %s", attribute, err.Error()),
+		)
+		return nil, diags
+	}
+
+	return configuration, diags
+}
+
+// parseCredentialTypeInputIDs returns the ids of the fields declared in the credential type inputs.
+func parseCredentialTypeInputIDs(value string) (map[string]bool, diag.Diagnostics) {
+	configuration, diags := decodeCredentialTypeConfiguration("inputs", value)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Convert the normalized configuration to the inputs API model
+	var inputs CredentialTypeInputsAPIModel
+	jsonInputs, _ := json.Marshal(configuration)
+	err := json.Unmarshal(jsonInputs, &inputs)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("inputs"),
+			"Invalid credential type configuration",
+			fmt.Sprintf("The inputs must contain a list of fields: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	ids := make(map[string]bool, len(inputs.Fields)+len(injectorBuiltinVariables))
+	for _, field := range inputs.Fields {
+		ids[field.Id] = true
+	}
+	for _, variable := range injectorBuiltinVariables {
+		ids[variable] = true
+	}
+
+	return ids, diags
+}
+
+// injectorTemplateVariables returns the sorted variables referenced by the templates of the injectors.
+func injectorTemplateVariables(injectors interface{}) []string {
+	found := map[string]bool{}
+
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch typed := value.(type) {
+		case string:
+			for _, match := range injectorVariableRegex.FindAllStringSubmatch(typed, -1) {
+				found[match[1]] = true
+			}
+		case map[string]interface{}:
+			for _, item := range typed {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range typed {
+				walk(item)
+			}
+		}
+	}
+	walk(injectors)
+
+	variables := make([]string, 0, len(found))
+	for variable := range found {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+
+	return variables
+}
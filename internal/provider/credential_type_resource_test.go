@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCredentialTypeResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the CredentialTypeResource and call its Schema method
+	NewCredentialTypeResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestCredentialTypeResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    CredentialTypeResourceModel
+		expected []byte
+	}{
+		{
+			name: "null values",
+			input: CredentialTypeResourceModel{
+				Name:        types.StringValue("test credential type"),
+				Description: types.StringNull(),
+				Kind:        types.StringValue("cloud"),
+				Inputs:      customtypes.NewAAPCustomStringNull(),
+				Injectors:   customtypes.NewAAPCustomStringNull(),
+			},
+			expected: []byte(`{"name":"test credential type","description":"","kind":"cloud","inputs":{},"injectors":{}}`),
+		},
+		{
+			name: "JSON and YAML values",
+			input: CredentialTypeResourceModel{
+				Name:        types.StringValue("test credential type"),
+				Description: types.StringValue("A test credential type"),
+				Kind:        types.StringValue("net"),
+				Inputs:      customtypes.NewAAPCustomStringValue(`{"fields":[{"id":"token","label":"Token","secret":true}]}`),
+				Injectors:   customtypes.NewAAPCustomStringValue("env:\n  API_TOKEN: '{{ token }}'\n"),
+			},
+			expected: []byte(`{"name":"test credential type","description":"A test credential type","kind":"net",` +
+				`"inputs":{"fields":[{"id":"token","label":"Token","secret":true}]},"injectors":{"env":{"API_TOKEN":"{{ token }}"}}}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCredentialTypeResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	yamlInputs := "fields:\n  - id: token\n    label: Token\n    secret: true\n"
+	response := []byte(`{"id":3,"url":"/api/v2/credential_types/3/","name":"test credential type","description":"",` +
+		`"kind":"cloud","inputs":{"fields":[{"id":"token","label":"Token","secret":true}]},"injectors":{}}`)
+
+	var testTable = []struct {
+		name     string
+		state    CredentialTypeResourceModel
+		input    []byte
+		expected CredentialTypeResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			state:    CredentialTypeResourceModel{},
+			input:    []byte("Not valid JSON"),
+			expected: CredentialTypeResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "equivalent YAML is kept",
+			state: CredentialTypeResourceModel{
+				Inputs:    customtypes.NewAAPCustomStringValue(yamlInputs),
+				Injectors: customtypes.NewAAPCustomStringNull(),
+			},
+			input: response,
+			expected: CredentialTypeResourceModel{
+				Id:          types.Int64Value(3),
+				Url:         types.StringValue("/api/v2/credential_types/3/"),
+				Name:        types.StringValue("test credential type"),
+				Description: types.StringNull(),
+				Kind:        types.StringValue("cloud"),
+				Inputs:      customtypes.NewAAPCustomStringValue(yamlInputs),
+				Injectors:   customtypes.NewAAPCustomStringNull(),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "changed values are replaced",
+			state: CredentialTypeResourceModel{
+				Inputs:    customtypes.NewAAPCustomStringValue("fields: []"),
+				Injectors: customtypes.NewAAPCustomStringValue("env: {}"),
+			},
+			input: response,
+			expected: CredentialTypeResourceModel{
+				Id:          types.Int64Value(3),
+				Url:         types.StringValue("/api/v2/credential_types/3/"),
+				Name:        types.StringValue("test credential type"),
+				Description: types.StringNull(),
+				Kind:        types.StringValue("cloud"),
+				Inputs:      customtypes.NewAAPCustomStringValue(`{"fields":[{"id":"token","label":"Token","secret":true}]}`),
+				Injectors:   customtypes.NewAAPCustomStringValue(`{}`),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := test.state
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if test.errors.HasError() {
+				return
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestInjectorTemplateVariables(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "no injectors",
+			input:    "",
+			expected: []string{},
+		},
+		{
+			name: "all injector kinds",
+			input: `{"env":{"TOKEN":"{{ token }}","CONFIG":"{{ tower.filename.config }}"},` +
+				`"extra_vars":{"user":"{{username | default('admin')}}","list":["{{-password-}}"]},` +
+				`"file":{"template.config":"[auth]\nuser={{ username }}"}}`,
+			expected: []string{"password", "token", "tower", "username"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			injectors, diags := decodeCredentialTypeConfiguration("injectors", test.input)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			actual := injectorTemplateVariables(injectors)
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseCredentialTypeInputIDs(t *testing.T) {
+	ids, diags := parseCredentialTypeInputIDs("fields:\n  - id: username\n  - id: password\n")
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	expected := map[string]bool{"username": true, "password": true, "tower": true, "awx": true}
+	if !reflect.DeepEqual(expected, ids) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, ids)
+	}
+
+	_, diags = parseCredentialTypeInputIDs("fields: not a list")
+	if !diags.HasError() {
+		t.Error("Expected an error for inputs without a list of fields")
+	}
+}
+
+// Acceptance tests
+
+func TestAccCredentialTypeResource(t *testing.T) {
+	var credentialType CredentialTypeAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Undeclared injector variables are rejected at plan time
+			{
+				Config:      testAccCredentialTypeResource(randomName, "{{ secret }}"),
+				ExpectError: regexp.MustCompile("Undeclared injector template variable"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCredentialTypeResource(randomName, "{{ token }}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCredentialTypeResourceExists("aap_credential_type.test", &credentialType),
+					resource.TestCheckResourceAttr("aap_credential_type.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_credential_type.test", "kind", "cloud"),
+				),
+			},
+			// YAML representations are stable
+			{
+				Config:   testAccCredentialTypeResource(randomName, "{{ token }}"),
+				PlanOnly: true,
+			},
+		},
+		CheckDestroy: testAccCheckCredentialTypeResourceDestroy,
+	})
+}
+
+// testAccCredentialTypeResource returns a configuration for an AAP Credential Type injecting the provided template.
+func testAccCredentialTypeResource(name string, template string) string {
+	return fmt.Sprintf(`
+resource "aap_credential_type" "test" {
+  name      = "%s"
+  inputs    = <<EOT
+fields:
+  - id: token
+    label: Token
+    secret: true
+EOT
+  injectors = yamlencode({ env = { API_TOKEN = "%s" } })
+}`, name, template)
+}
+
+// testAccCheckCredentialTypeResourceExists queries the AAP API and retrieves the matching credential type.
+func testAccCheckCredentialTypeResourceExists(name string, credentialType *CredentialTypeAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		credentialTypeResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("credential type (%s) not found in state", name)
+		}
+
+		credentialTypeResponseBody, err := testGetResource(credentialTypeResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(credentialTypeResponseBody, &credentialType)
+		if err != nil {
+			return err
+		}
+
+		if credentialType.Id == 0 {
+			return fmt.Errorf("credential type (%s) not found in AAP", credentialTypeResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckCredentialTypeResourceDestroy verifies the credential type has been destroyed.
+func testAccCheckCredentialTypeResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_credential_type" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("credential type (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
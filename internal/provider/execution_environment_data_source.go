@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ExecutionEnvironmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &ExecutionEnvironmentDataSource{}
+)
+
+// NewExecutionEnvironmentDataSource is a helper function to simplify the provider implementation.
+func NewExecutionEnvironmentDataSource() datasource.DataSource {
+	return &ExecutionEnvironmentDataSource{}
+}
+
+// ExecutionEnvironmentDataSource is the data source implementation.
+type ExecutionEnvironmentDataSource struct {
+	client *AAPClient
+}
+
+// Metadata returns the data source type name.
+func (d *ExecutionEnvironmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execution_environment"
+}
+
+// Schema defines the schema for the data source.
+func (d *ExecutionEnvironmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the execution environment",
+			},
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Execution environment id",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url of the execution environment",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the execution environment",
+			},
+			"organization": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Identifier for the organization the execution environment belongs to",
+			},
+			"image": schema.StringAttribute{
+				Computed:    true,
+				Description: "Full image location of the execution environment",
+			},
+			"pull": schema.StringAttribute{
+				Computed:    true,
+				Description: "Pull policy of the image",
+			},
+			"credential": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Identifier for the container registry credential used to pull the image",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ExecutionEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ExecutionEnvironmentDataSourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponseBody, diags := getResourceByName(d.client, "/api/v2/execution_environments/", state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = state.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ExecutionEnvironmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ExecutionEnvironmentDataSourceModel maps the data source schema data.
+type ExecutionEnvironmentDataSourceModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	Url          types.String `tfsdk:"url"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Organization types.Int64  `tfsdk:"organization"`
+	Image        types.String `tfsdk:"image"`
+	Pull         types.String `tfsdk:"pull"`
+	Credential   types.Int64  `tfsdk:"credential"`
+}
+
+func (d *ExecutionEnvironmentDataSourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiExecutionEnvironment ExecutionEnvironmentAPIModel
+	err := json.Unmarshal(body, &apiExecutionEnvironment)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the execution environment datasource schema
+	d.Id = types.Int64Value(apiExecutionEnvironment.Id)
+	d.Url = types.StringValue(apiExecutionEnvironment.Url)
+	d.Name = types.StringValue(apiExecutionEnvironment.Name)
+	d.Description = ParseStringValue(apiExecutionEnvironment.Description)
+	d.Organization = types.Int64PointerValue(apiExecutionEnvironment.Organization)
+	d.Image = types.StringValue(apiExecutionEnvironment.Image)
+	d.Pull = ParseStringValue(apiExecutionEnvironment.Pull)
+	d.Credential = types.Int64PointerValue(apiExecutionEnvironment.Credential)
+
+	return diags
+}
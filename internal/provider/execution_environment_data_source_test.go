@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestExecutionEnvironmentDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwdatasource.SchemaRequest{}
+	schemaResponse := &fwdatasource.SchemaResponse{}
+
+	// Instantiate the ExecutionEnvironmentDataSource and call its Schema method
+	NewExecutionEnvironmentDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestExecutionEnvironmentDataSourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected ExecutionEnvironmentDataSourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: ExecutionEnvironmentDataSourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "missing values",
+			input: []byte(`{"id":1,"name":"AWX EE (latest)","url":"/api/v2/execution_environments/1/",` +
+				`"image":"quay.io/ansible/awx-ee:latest"}`),
+			expected: ExecutionEnvironmentDataSourceModel{
+				Id:           types.Int64Value(1),
+				Url:          types.StringValue("/api/v2/execution_environments/1/"),
+				Name:         types.StringValue("AWX EE (latest)"),
+				Description:  types.StringNull(),
+				Organization: types.Int64Null(),
+				Image:        types.StringValue("quay.io/ansible/awx-ee:latest"),
+				Pull:         types.StringNull(),
+				Credential:   types.Int64Null(),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "all values",
+			input: []byte(`{"id":5,"name":"custom","url":"/api/v2/execution_environments/5/","description":"A custom EE",` +
+				`"organization":2,"image":"registry.example.com/custom-ee:1.0","pull":"always","credential":3}`),
+			expected: ExecutionEnvironmentDataSourceModel{
+				Id:           types.Int64Value(5),
+				Url:          types.StringValue("/api/v2/execution_environments/5/"),
+				Name:         types.StringValue("custom"),
+				Description:  types.StringValue("A custom EE"),
+				Organization: types.Int64Value(2),
+				Image:        types.StringValue("registry.example.com/custom-ee:1.0"),
+				Pull:         types.StringValue("always"),
+				Credential:   types.Int64Value(3),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := ExecutionEnvironmentDataSourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), Received (%s)", test.errors, diags)
+			}
+			if test.expected != resource {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestAccExecutionEnvironmentDataSource(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create an execution environment and Read it by name
+			{
+				Config: testAccExecutionEnvironmentDataSource(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aap_execution_environment.test", "id", "aap_execution_environment.test", "id"),
+					resource.TestCheckResourceAttrPair("data.aap_execution_environment.test", "url", "aap_execution_environment.test", "url"),
+					resource.TestCheckResourceAttr("data.aap_execution_environment.test", "image", "quay.io/ansible/awx-ee:latest"),
+					resource.TestCheckResourceAttr("data.aap_execution_environment.test", "pull", "missing"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckExecutionEnvironmentResourceDestroy,
+	})
+}
+
+// testAccExecutionEnvironmentDataSource configures the Execution Environment Data Source for testing
+func testAccExecutionEnvironmentDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "aap_execution_environment" "test" {
+  name  = "%s"
+  image = "quay.io/ansible/awx-ee:latest"
+  pull  = "missing"
+}
+
+data "aap_execution_environment" "test" {
+  name = aap_execution_environment.test.name
+}
+`, name)
+}
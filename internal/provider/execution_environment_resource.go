@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Execution environment AAP API model
+type ExecutionEnvironmentAPIModel struct {
+	Id           int64  `json:"id,omitempty"`
+	Url          string `json:"url,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Organization *int64 `json:"organization"`
+	Image        string `json:"image"`
+	Pull         string `json:"pull"`
+	Credential   *int64 `json:"credential"`
+}
+
+// ExecutionEnvironmentResourceModel maps the execution environment resource schema to a Go struct.
+type ExecutionEnvironmentResourceModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	Url          types.String `tfsdk:"url"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Organization types.Int64  `tfsdk:"organization"`
+	Image        types.String `tfsdk:"image"`
+	Pull         types.String `tfsdk:"pull"`
+	Credential   types.Int64  `tfsdk:"credential"`
+}
+
+// ExecutionEnvironmentResource is the resource implementation.
+type ExecutionEnvironmentResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &ExecutionEnvironmentResource{}
+	_ resource.ResourceWithConfigure = &ExecutionEnvironmentResource{}
+)
+
+// NewExecutionEnvironmentResource is a helper function to simplify the provider implementation.
+func NewExecutionEnvironmentResource() resource.Resource {
+	return &ExecutionEnvironmentResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ExecutionEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execution_environment"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ExecutionEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the execution environment resource.
+func (r *ExecutionEnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Execution environment id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the execution environment",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the execution environment",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the execution environment",
+			},
+			"organization": schema.Int64Attribute{
+				Optional: true,
+				Description: "Identifier for the organization the execution environment belongs to. " +
+					"If not provided, the execution environment is available to all organizations.",
+			},
+			"image": schema.StringAttribute{
+				Required:    true,
+				Description: "Full image location, including the container registry, image name and version tag.",
+			},
+			"pull": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("always", "missing", "never")},
+				Description: "Pull policy of the image, one of always, missing or never. If not provided, the default policy of AAP is used.",
+			},
+			"credential": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the container registry credential used to pull the image.",
+			},
+		},
+	}
+}
+
+// Create creates the execution environment resource and sets the Terraform state on success.
+func (r *ExecutionEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExecutionEnvironmentResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into execution environment resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from execution environment data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new execution environment in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/execution_environments/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new execution environment data into execution environment resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest execution environment data.
+func (r *ExecutionEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExecutionEnvironmentResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into execution environment resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest execution environment data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest execution environment data into execution environment resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the execution environment resource and sets the updated Terraform state on success.
+func (r *ExecutionEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExecutionEnvironmentResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into execution environment resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from execution environment data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update execution environment in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated execution environment data into execution environment resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the execution environment resource.
+func (r *ExecutionEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExecutionEnvironmentResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into execution environment resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete execution environment from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the execution environment resource data
+func (r *ExecutionEnvironmentResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert execution environment resource data to API data model
+	executionEnvironment := ExecutionEnvironmentAPIModel{
+		Name:         r.Name.ValueString(),
+		Description:  r.Description.ValueString(),
+		Organization: r.Organization.ValueInt64Pointer(),
+		Image:        r.Image.ValueString(),
+		Pull:         r.Pull.ValueString(),
+		Credential:   r.Credential.ValueInt64Pointer(),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(executionEnvironment)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for execution environment resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHttpResponse updates the execution environment resource data from an AAP API response
+func (r *ExecutionEnvironmentResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiExecutionEnvironment ExecutionEnvironmentAPIModel
+	err := json.Unmarshal(body, &apiExecutionEnvironment)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the execution environment resource schema and update attribute values
+	r.Id = types.Int64Value(apiExecutionEnvironment.Id)
+	r.Url = types.StringValue(apiExecutionEnvironment.Url)
+	r.Name = types.StringValue(apiExecutionEnvironment.Name)
+	r.Description = ParseStringValue(apiExecutionEnvironment.Description)
+	r.Organization = types.Int64PointerValue(apiExecutionEnvironment.Organization)
+	r.Image = types.StringValue(apiExecutionEnvironment.Image)
+	r.Pull = ParseStringValue(apiExecutionEnvironment.Pull)
+	r.Credential = types.Int64PointerValue(apiExecutionEnvironment.Credential)
+
+	return diags
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestExecutionEnvironmentResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the ExecutionEnvironmentResource and call its Schema method
+	NewExecutionEnvironmentResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestExecutionEnvironmentResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    ExecutionEnvironmentResourceModel
+		expected []byte
+	}{
+		{
+			name: "global execution environment",
+			input: ExecutionEnvironmentResourceModel{
+				Name:         types.StringValue("test ee"),
+				Description:  types.StringNull(),
+				Organization: types.Int64Null(),
+				Image:        types.StringValue("quay.io/ansible/awx-ee:latest"),
+				Pull:         types.StringNull(),
+				Credential:   types.Int64Null(),
+			},
+			expected: []byte(`{"name":"test ee","description":"","organization":null,"image":"quay.io/ansible/awx-ee:latest",` +
+				`"pull":"","credential":null}`),
+		},
+		{
+			name: "provided values",
+			input: ExecutionEnvironmentResourceModel{
+				Name:         types.StringValue("test ee"),
+				Description:  types.StringValue("A test execution environment"),
+				Organization: types.Int64Value(2),
+				Image:        types.StringValue("registry.example.com/custom-ee:1.0"),
+				Pull:         types.StringValue("always"),
+				Credential:   types.Int64Value(5),
+			},
+			expected: []byte(`{"name":"test ee","description":"A test execution environment","organization":2,` +
+				`"image":"registry.example.com/custom-ee:1.0","pull":"always","credential":5}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExecutionEnvironmentResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected ExecutionEnvironmentResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: ExecutionEnvironmentResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "global execution environment",
+			input: []byte(`{"id":4,"url":"/api/v2/execution_environments/4/","name":"test ee","description":"",` +
+				`"organization":null,"image":"quay.io/ansible/awx-ee:latest","managed":false,"credential":null,"pull":""}`),
+			expected: ExecutionEnvironmentResourceModel{
+				Id:           types.Int64Value(4),
+				Url:          types.StringValue("/api/v2/execution_environments/4/"),
+				Name:         types.StringValue("test ee"),
+				Description:  types.StringNull(),
+				Organization: types.Int64Null(),
+				Image:        types.StringValue("quay.io/ansible/awx-ee:latest"),
+				Pull:         types.StringNull(),
+				Credential:   types.Int64Null(),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "provided values",
+			input: []byte(`{"id":5,"url":"/api/v2/execution_environments/5/","name":"test ee",` +
+				`"description":"A test execution environment","organization":2,"image":"registry.example.com/custom-ee:1.0",` +
+				`"managed":false,"credential":5,"pull":"always"}`),
+			expected: ExecutionEnvironmentResourceModel{
+				Id:           types.Int64Value(5),
+				Url:          types.StringValue("/api/v2/execution_environments/5/"),
+				Name:         types.StringValue("test ee"),
+				Description:  types.StringValue("A test execution environment"),
+				Organization: types.Int64Value(2),
+				Image:        types.StringValue("registry.example.com/custom-ee:1.0"),
+				Pull:         types.StringValue("always"),
+				Credential:   types.Int64Value(5),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := ExecutionEnvironmentResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccExecutionEnvironmentResource(t *testing.T) {
+	var executionEnvironment ExecutionEnvironmentAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccExecutionEnvironmentResource(randomName, "missing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExecutionEnvironmentResourceExists("aap_execution_environment.test", &executionEnvironment),
+					resource.TestCheckResourceAttr("aap_execution_environment.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_execution_environment.test", "image", "quay.io/ansible/awx-ee:latest"),
+					resource.TestCheckResourceAttr("aap_execution_environment.test", "pull", "missing"),
+					resource.TestCheckResourceAttr("aap_execution_environment.test", "organization", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccExecutionEnvironmentResource(randomName, "always"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExecutionEnvironmentResourceExists("aap_execution_environment.test", &executionEnvironment),
+					resource.TestCheckResourceAttr("aap_execution_environment.test", "pull", "always"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckExecutionEnvironmentResourceDestroy,
+	})
+}
+
+// testAccExecutionEnvironmentResource returns a configuration for an AAP Execution environment with the provided pull policy.
+func testAccExecutionEnvironmentResource(name string, pull string) string {
+	return fmt.Sprintf(`
+resource "aap_execution_environment" "test" {
+  name         = "%s"
+  organization = 1
+  image        = "quay.io/ansible/awx-ee:latest"
+  pull         = "%s"
+}`, name, pull)
+}
+
+// testAccCheckExecutionEnvironmentResourceExists queries the AAP API and retrieves the matching execution environment.
+func testAccCheckExecutionEnvironmentResourceExists(name string, executionEnvironment *ExecutionEnvironmentAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		executionEnvironmentResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("execution environment (%s) not found in state", name)
+		}
+
+		executionEnvironmentResponseBody, err := testGetResource(executionEnvironmentResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(executionEnvironmentResponseBody, &executionEnvironment)
+		if err != nil {
+			return err
+		}
+
+		if executionEnvironment.Id == 0 {
+			return fmt.Errorf("execution environment (%s) not found in AAP", executionEnvironmentResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckExecutionEnvironmentResourceDestroy verifies the execution environment has been destroyed.
+func testAccCheckExecutionEnvironmentResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_execution_environment" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("execution environment (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
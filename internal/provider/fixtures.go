@@ -14,6 +14,20 @@ var JobResponse3 = map[string]string{
 	"status":                "complete",
 	"execution_environment": "3",
 }
+
+var JobResponse4 = map[string]string{
+	"status": "successful",
+}
+
+var JobResponse5 = map[string]string{
+	"status": "failed",
+}
+
+var JobResponse6 = map[string]string{
+	"status": "pending",
+	"url":    "/api/v2/jobs/5/",
+}
+
 var GroupResponse1 = map[string]string{
 	"description": "",
 	"inventory":   "1",
@@ -38,6 +52,9 @@ var MockConfig = map[string]map[string]string{
 	"/api/v2/job_templates/2/launch/": JobResponse2,
 	"/api/v2/jobs/1/":                 JobResponse1,
 	"/api/v2/jobs/2/":                 JobResponse3,
+	"/api/v2/jobs/3/":                 JobResponse4,
+	"/api/v2/jobs/4/":                 JobResponse5,
+	"/api/v2/jobs/4/relaunch/":        JobResponse6,
 	"/api/v2/groups/":                 GroupResponse1,
 	"/api/v2/groups/1/":               GroupResponse2,
 	"/api/v2/groups/2/":               GroupResponse3,
@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const maxPolicyInstancePercentage = 100
+
+// Instance group AAP API model
+type InstanceGroupAPIModel struct {
+	Id                       int64    `json:"id,omitempty"`
+	Url                      string   `json:"url,omitempty"`
+	Name                     string   `json:"name"`
+	IsContainerGroup         bool     `json:"is_container_group"`
+	PolicyInstancePercentage int64    `json:"policy_instance_percentage"`
+	PolicyInstanceMinimum    int64    `json:"policy_instance_minimum"`
+	PolicyInstanceList       []string `json:"policy_instance_list"`
+	MaxConcurrentJobs        int64    `json:"max_concurrent_jobs"`
+	MaxForks                 int64    `json:"max_forks"`
+	PodSpecOverride          string   `json:"pod_spec_override"`
+	Credential               *int64   `json:"credential"`
+}
+
+// InstanceGroupResourceModel maps the instance group resource schema to a Go struct.
+type InstanceGroupResourceModel struct {
+	Id                       types.Int64                      `tfsdk:"id"`
+	Url                      types.String                     `tfsdk:"url"`
+	Name                     types.String                     `tfsdk:"name"`
+	IsContainerGroup         types.Bool                       `tfsdk:"is_container_group"`
+	PolicyInstancePercentage types.Int64                      `tfsdk:"policy_instance_percentage"`
+	PolicyInstanceMinimum    types.Int64                      `tfsdk:"policy_instance_minimum"`
+	PolicyInstanceList       types.List                       `tfsdk:"policy_instance_list"`
+	MaxConcurrentJobs        types.Int64                      `tfsdk:"max_concurrent_jobs"`
+	MaxForks                 types.Int64                      `tfsdk:"max_forks"`
+	PodSpecOverride          customtypes.AAPCustomStringValue `tfsdk:"pod_spec_override"`
+	Credential               types.Int64                      `tfsdk:"credential"`
+}
+
+// InstanceGroupResource is the resource implementation.
+type InstanceGroupResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &InstanceGroupResource{}
+	_ resource.ResourceWithConfigure      = &InstanceGroupResource{}
+	_ resource.ResourceWithValidateConfig = &InstanceGroupResource{}
+)
+
+// NewInstanceGroupResource is a helper function to simplify the provider implementation.
+func NewInstanceGroupResource() resource.Resource {
+	return &InstanceGroupResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *InstanceGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_group"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *InstanceGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the instance group resource.
+func (r *InstanceGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Instance group id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the instance group",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the instance group",
+			},
+			"is_container_group": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Description: "Create a container group running jobs as pods in a Kubernetes or OpenShift cluster. " +
+					"Defaults to false. Changing it forces a new instance group.",
+			},
+			"policy_instance_percentage": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.Between(0, maxPolicyInstancePercentage)},
+				Description: "Minimum percentage of all instances automatically assigned to the instance group. Defaults to 0.",
+			},
+			"policy_instance_minimum": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Minimum number of instances automatically assigned to the instance group. Defaults to 0.",
+			},
+			"policy_instance_list": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description: "Hostnames of the instances always assigned to the instance group.",
+			},
+			"max_concurrent_jobs": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Maximum number of jobs running concurrently on the instance group. Defaults to 0, which means no limit.",
+			},
+			"max_forks": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Maximum number of forks running concurrently on the instance group. Defaults to 0, which means no limit.",
+			},
+			"pod_spec_override": schema.StringAttribute{
+				Optional:   true,
+				CustomType: customtypes.AAPCustomStringType{},
+				Description: "Custom Kubernetes or OpenShift pod specification of a container group. " +
+					"Must be provided as either a JSON or YAML string.",
+			},
+			"credential": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the OpenShift or Kubernetes API bearer token credential used by a container group.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the container group and policy attributes match the kind of instance group.
+func (r *InstanceGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InstanceGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsContainerGroup.IsUnknown() {
+		return
+	}
+
+	if data.IsContainerGroup.ValueBool() {
+		policyAttributes := map[string]attr.Value{
+			"policy_instance_percentage": data.PolicyInstancePercentage,
+			"policy_instance_minimum":    data.PolicyInstanceMinimum,
+			"policy_instance_list":       data.PolicyInstanceList,
+		}
+		for name, value := range policyAttributes {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid attribute for container group",
+					fmt.Sprintf("%s can not be set for container groups, since their pods are not run on AAP instances.", name))
+			}
+		}
+		return
+	}
+
+	containerGroupAttributes := map[string]attr.Value{
+		"pod_spec_override": data.PodSpecOverride,
+		"credential":        data.Credential,
+	}
+	for name, value := range containerGroupAttributes {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid attribute for instance group",
+				fmt.Sprintf("%s can only be set for container groups.", name))
+		}
+	}
+}
+
+// Create creates the instance group resource and sets the Terraform state on success.
+func (r *InstanceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InstanceGroupResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into instance group resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from instance group data
+	createRequestBody, diags := data.CreateRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new instance group in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/instance_groups/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new instance group data into instance group resource model
+	diags = data.ParseHttpResponse(ctx, createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest instance group data.
+func (r *InstanceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceGroupResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into instance group resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest instance group data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest instance group data into instance group resource model
+	diags = data.ParseHttpResponse(ctx, readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the instance group resource and sets the updated Terraform state on success.
+func (r *InstanceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InstanceGroupResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into instance group resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from instance group data
+	updateRequestBody, diags := data.CreateRequestBody(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update instance group in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated instance group data into instance group resource model
+	diags = data.ParseHttpResponse(ctx, updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the instance group resource.
+func (r *InstanceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InstanceGroupResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into instance group resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete instance group from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the instance group resource data
+func (r *InstanceGroupResourceModel) CreateRequestBody(ctx context.Context) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert instance group resource data to API data model
+	instanceGroup := InstanceGroupAPIModel{
+		Name:                     r.Name.ValueString(),
+		IsContainerGroup:         r.IsContainerGroup.ValueBool(),
+		PolicyInstancePercentage: r.PolicyInstancePercentage.ValueInt64(),
+		PolicyInstanceMinimum:    r.PolicyInstanceMinimum.ValueInt64(),
+		PolicyInstanceList:       []string{},
+		MaxConcurrentJobs:        r.MaxConcurrentJobs.ValueInt64(),
+		MaxForks:                 r.MaxForks.ValueInt64(),
+		PodSpecOverride:          r.PodSpecOverride.ValueString(),
+		Credential:               r.Credential.ValueInt64Pointer(),
+	}
+
+	if IsValueProvided(r.PolicyInstanceList) {
+		diags.Append(r.PolicyInstanceList.ElementsAs(ctx, &instanceGroup.PolicyInstanceList, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(instanceGroup)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for instance group resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the instance group resource data from an AAP API response
+func (r *InstanceGroupResourceModel) ParseHttpResponse(ctx context.Context, body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiInstanceGroup InstanceGroupAPIModel
+	err := json.Unmarshal(body, &apiInstanceGroup)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the instance group resource schema and update attribute values
+	r.Id = types.Int64Value(apiInstanceGroup.Id)
+	r.Url = types.StringValue(apiInstanceGroup.Url)
+	r.Name = types.StringValue(apiInstanceGroup.Name)
+	r.IsContainerGroup = types.BoolValue(apiInstanceGroup.IsContainerGroup)
+	r.PolicyInstancePercentage = types.Int64Value(apiInstanceGroup.PolicyInstancePercentage)
+	r.PolicyInstanceMinimum = types.Int64Value(apiInstanceGroup.PolicyInstanceMinimum)
+	r.MaxConcurrentJobs = types.Int64Value(apiInstanceGroup.MaxConcurrentJobs)
+	r.MaxForks = types.Int64Value(apiInstanceGroup.MaxForks)
+	r.PodSpecOverride = ParseAAPCustomStringValue(apiInstanceGroup.PodSpecOverride)
+	r.Credential = types.Int64PointerValue(apiInstanceGroup.Credential)
+
+	policyInstanceList := apiInstanceGroup.PolicyInstanceList
+	if policyInstanceList == nil {
+		policyInstanceList = []string{}
+	}
+	r.PolicyInstanceList, diags = types.ListValueFrom(ctx, types.StringType, policyInstanceList)
+
+	return diags
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestInstanceGroupResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the InstanceGroupResource and call its Schema method
+	NewInstanceGroupResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestInstanceGroupResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    InstanceGroupResourceModel
+		expected []byte
+	}{
+		{
+			name: "instance group",
+			input: InstanceGroupResourceModel{
+				Name:                     types.StringValue("test group"),
+				IsContainerGroup:         types.BoolValue(false),
+				PolicyInstancePercentage: types.Int64Value(50),
+				PolicyInstanceMinimum:    types.Int64Value(1),
+				PolicyInstanceList: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("node1"),
+					types.StringValue("node2"),
+				}),
+				MaxConcurrentJobs: types.Int64Value(10),
+				MaxForks:          types.Int64Value(100),
+				PodSpecOverride:   customtypes.NewAAPCustomStringNull(),
+				Credential:        types.Int64Null(),
+			},
+			expected: []byte(`{"name":"test group","is_container_group":false,"policy_instance_percentage":50,` +
+				`"policy_instance_minimum":1,"policy_instance_list":["node1","node2"],"max_concurrent_jobs":10,"max_forks":100,` +
+				`"pod_spec_override":"","credential":null}`),
+		},
+		{
+			name: "container group",
+			input: InstanceGroupResourceModel{
+				Name:                     types.StringValue("test group"),
+				IsContainerGroup:         types.BoolValue(true),
+				PolicyInstancePercentage: types.Int64Value(0),
+				PolicyInstanceMinimum:    types.Int64Value(0),
+				PolicyInstanceList:       types.ListValueMust(types.StringType, []attr.Value{}),
+				MaxConcurrentJobs:        types.Int64Value(0),
+				MaxForks:                 types.Int64Value(0),
+				PodSpecOverride:          customtypes.NewAAPCustomStringValue("apiVersion: v1\nkind: Pod"),
+				Credential:               types.Int64Value(7),
+			},
+			expected: []byte(`{"name":"test group","is_container_group":true,"policy_instance_percentage":0,` +
+				`"policy_instance_minimum":0,"policy_instance_list":[],"max_concurrent_jobs":0,"max_forks":0,` +
+				`"pod_spec_override":"apiVersion: v1\nkind: Pod","credential":7}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody(context.Background())
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInstanceGroupResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected InstanceGroupResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: InstanceGroupResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "instance group",
+			input: []byte(`{"id":3,"url":"/api/v2/instance_groups/3/","name":"test group","is_container_group":false,` +
+				`"policy_instance_percentage":50,"policy_instance_minimum":1,"policy_instance_list":["node1"],` +
+				`"max_concurrent_jobs":10,"max_forks":100,"pod_spec_override":"","credential":null}`),
+			expected: InstanceGroupResourceModel{
+				Id:                       types.Int64Value(3),
+				Url:                      types.StringValue("/api/v2/instance_groups/3/"),
+				Name:                     types.StringValue("test group"),
+				IsContainerGroup:         types.BoolValue(false),
+				PolicyInstancePercentage: types.Int64Value(50),
+				PolicyInstanceMinimum:    types.Int64Value(1),
+				PolicyInstanceList:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("node1")}),
+				MaxConcurrentJobs:        types.Int64Value(10),
+				MaxForks:                 types.Int64Value(100),
+				PodSpecOverride:          customtypes.NewAAPCustomStringNull(),
+				Credential:               types.Int64Null(),
+			},
+			errors: nil,
+		},
+		{
+			name: "container group",
+			input: []byte(`{"id":4,"url":"/api/v2/instance_groups/4/","name":"test group","is_container_group":true,` +
+				`"policy_instance_percentage":0,"policy_instance_minimum":0,"policy_instance_list":[],` +
+				`"max_concurrent_jobs":0,"max_forks":0,"pod_spec_override":"apiVersion: v1\nkind: Pod","credential":7}`),
+			expected: InstanceGroupResourceModel{
+				Id:                       types.Int64Value(4),
+				Url:                      types.StringValue("/api/v2/instance_groups/4/"),
+				Name:                     types.StringValue("test group"),
+				IsContainerGroup:         types.BoolValue(true),
+				PolicyInstancePercentage: types.Int64Value(0),
+				PolicyInstanceMinimum:    types.Int64Value(0),
+				PolicyInstanceList:       types.ListValueMust(types.StringType, []attr.Value{}),
+				MaxConcurrentJobs:        types.Int64Value(0),
+				MaxForks:                 types.Int64Value(0),
+				PodSpecOverride:          customtypes.NewAAPCustomStringValue("apiVersion: v1\nkind: Pod"),
+				Credential:               types.Int64Value(7),
+			},
+			errors: nil,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := InstanceGroupResourceModel{}
+			diags := resource.ParseHttpResponse(context.Background(), test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccInstanceGroupResource(t *testing.T) {
+	var instanceGroup InstanceGroupAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInstanceGroupResource(randomName, "[aap_instance_group.test.id, aap_instance_group.container.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceGroupResourceExists("aap_instance_group.test", &instanceGroup),
+					resource.TestCheckResourceAttr("aap_instance_group.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_instance_group.test", "policy_instance_percentage", "50"),
+					resource.TestCheckResourceAttr("aap_instance_group.test", "max_forks", "100"),
+					resource.TestCheckResourceAttr("aap_instance_group.container", "is_container_group", "true"),
+					resource.TestCheckResourceAttrPair("aap_inventory.test", "instance_groups.0", "aap_instance_group.test", "id"),
+					resource.TestCheckResourceAttrPair("aap_inventory.test", "instance_groups.1", "aap_instance_group.container", "id"),
+					resource.TestCheckResourceAttrPair("aap_organization.test", "instance_groups.0", "aap_instance_group.test", "id"),
+				),
+			},
+			// Reorder the associated instance groups
+			{
+				Config: testAccInstanceGroupResource(randomName, "[aap_instance_group.container.id, aap_instance_group.test.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("aap_inventory.test", "instance_groups.0", "aap_instance_group.container", "id"),
+					resource.TestCheckResourceAttrPair("aap_inventory.test", "instance_groups.1", "aap_instance_group.test", "id"),
+					resource.TestCheckResourceAttrPair("aap_organization.test", "instance_groups.0", "aap_instance_group.container", "id"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckInstanceGroupResourceDestroy,
+	})
+}
+
+// testAccInstanceGroupResource returns a configuration for an AAP Instance group and a container group,
+// associated in the provided order with an inventory and an organization.
+func testAccInstanceGroupResource(name string, instanceGroups string) string {
+	return fmt.Sprintf(`
+resource "aap_instance_group" "test" {
+  name                       = "%[1]s"
+  policy_instance_percentage = 50
+  max_forks                  = 100
+}
+
+resource "aap_instance_group" "container" {
+  name               = "%[1]s container"
+  is_container_group = true
+  pod_spec_override  = <<EOT
+apiVersion: v1
+kind: Pod
+metadata:
+  namespace: awx
+EOT
+}
+
+resource "aap_inventory" "test" {
+  name            = "%[1]s"
+  instance_groups = %[2]s
+}
+
+resource "aap_organization" "test" {
+  name            = "%[1]s"
+  instance_groups = %[2]s
+}`, name, instanceGroups)
+}
+
+// testAccCheckInstanceGroupResourceExists queries the AAP API and retrieves the matching instance group.
+func testAccCheckInstanceGroupResourceExists(name string, instanceGroup *InstanceGroupAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		instanceGroupResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("instance group (%s) not found in state", name)
+		}
+
+		instanceGroupResponseBody, err := testGetResource(instanceGroupResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(instanceGroupResponseBody, &instanceGroup)
+		if err != nil {
+			return err
+		}
+
+		if instanceGroup.Id == 0 {
+			return fmt.Errorf("instance group (%s) not found in AAP", instanceGroupResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckInstanceGroupResourceDestroy verifies the instance group has been destroyed.
+func testAccCheckInstanceGroupResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_instance_group" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("instance group (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,22 +4,40 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const (
+	inventoryKindSmart       = "smart"
+	inventoryKindConstructed = "constructed"
+)
+
+// hostFilterTermRegex matches a single field lookup of a smart inventory host filter, such as name__icontains=web.
+var hostFilterTermRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.\[\]]*=.+$`)
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &InventoryResource{}
-	_ resource.ResourceWithConfigure = &InventoryResource{}
+	_ resource.Resource                   = &InventoryResource{}
+	_ resource.ResourceWithConfigure      = &InventoryResource{}
+	_ resource.ResourceWithValidateConfig = &InventoryResource{}
 )
 
 // NewInventoryResource is a helper function to simplify the provider implementation.
@@ -96,10 +114,96 @@ func (r *InventoryResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 				CustomType:  customtypes.AAPCustomStringType{},
 			},
+			"kind": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(inventoryKindSmart, inventoryKindConstructed),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Kind of the inventory, either smart or constructed. If not provided, a regular inventory is created. " +
+					"Changing it forces a new inventory.",
+			},
+			"host_filter": schema.StringAttribute{
+				Optional: true,
+				Description: "Filter selecting the hosts of a smart inventory, such as `name__icontains=web and enabled=true`. " +
+					"Required for smart inventories.",
+			},
+			"input_inventory_ids": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Ordered list of identifiers for the inventories a constructed inventory is built from.",
+			},
+			"source_vars": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+				Description: "Variables of the constructed inventory plugin. Must be provided as either a JSON or YAML string.",
+			},
+			"limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "Host pattern limiting the hosts of a constructed inventory.",
+			},
+			"instance_groups": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Ordered list of identifiers for the instance groups preferred to run jobs using the inventory. " +
+					"If not provided, the instance groups assigned by AAP are left untouched.",
+			},
 		},
 	}
 }
 
+// ValidateConfig checks that the smart and constructed inventory attributes match the inventory kind.
+func (r *InventoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data inventoryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if IsValueProvided(data.HostFilter) {
+		err := validateHostFilter(data.HostFilter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("host_filter"), "Invalid host filter", err.Error())
+		}
+	}
+
+	if data.Kind.IsUnknown() {
+		return
+	}
+	kind := data.Kind.ValueString()
+
+	if kind == inventoryKindSmart && data.HostFilter.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("host_filter"), "Missing host filter", "A host_filter is required for smart inventories.")
+	}
+	if kind != inventoryKindSmart && !data.HostFilter.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("host_filter"), "Invalid attribute for inventory kind",
+			"host_filter can only be set for smart inventories.")
+	}
+
+	constructedAttributes := map[string]attr.Value{
+		"input_inventory_ids": data.InputInventoryIds,
+		"source_vars":         data.SourceVars,
+		"limit":               data.Limit,
+	}
+	for name, value := range constructedAttributes {
+		if kind != inventoryKindConstructed && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid attribute for inventory kind",
+				fmt.Sprintf("%s can only be set for constructed inventories.", name))
+		}
+	}
+}
+
 // Create creates the inventory resource and sets the Terraform state on success.
 func (r *InventoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data inventoryResourceModel
@@ -120,8 +224,12 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 	}
 	requestData := bytes.NewReader(createRequestBody)
 
-	// Create new inventory in AAP
-	createResponseBody, diags := r.client.Create("/api/v2/inventories/", requestData)
+	// Create new inventory in AAP, constructed inventories have their own endpoint
+	createPath := "/api/v2/inventories/"
+	if data.Kind.ValueString() == inventoryKindConstructed {
+		createPath = "/api/v2/constructed_inventories/"
+	}
+	createResponseBody, diags := r.client.Create(createPath, requestData)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -134,6 +242,23 @@ func (r *InventoryResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	// Save the created inventory into Terraform state, so that it is tracked even if handling its input inventories
+	// or instance groups fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.handleInputInventories(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.handleInstanceGroups(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated state
 	diags = resp.State.Set(ctx, data)
 	resp.Diagnostics.Append(diags...)
@@ -155,7 +280,7 @@ func (r *InventoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get latest inventory data from AAP
-	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	readResponseBody, diags := r.client.Get(data.inventoryURL())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -168,6 +293,16 @@ func (r *InventoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.readInputInventories(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readInstanceGroups(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated state
 	diags = resp.State.Set(ctx, data)
 	resp.Diagnostics.Append(diags...)
@@ -197,7 +332,7 @@ func (r *InventoryResource) Update(ctx context.Context, req resource.UpdateReque
 	requestData := bytes.NewReader(updateRequestBody)
 
 	// Update inventory in AAP
-	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	updateResponseBody, diags := r.client.Update(data.inventoryURL(), requestData)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -210,6 +345,16 @@ func (r *InventoryResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	resp.Diagnostics.Append(r.handleInputInventories(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.handleInstanceGroups(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated state
 	diags = resp.State.Set(ctx, data)
 	resp.Diagnostics.Append(diags...)
@@ -231,7 +376,7 @@ func (r *InventoryResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	// Delete inventory from AAP
-	_, diags = r.client.Delete(data.Url.ValueString())
+	_, diags = r.client.Delete(data.inventoryURL())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -240,12 +385,18 @@ func (r *InventoryResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // InventoryResourceModel maps the inventory resource schema to a Go struct.
 type inventoryResourceModel struct {
-	Id           types.Int64                      `tfsdk:"id"`
-	Organization types.Int64                      `tfsdk:"organization"`
-	Url          types.String                     `tfsdk:"url"`
-	Name         types.String                     `tfsdk:"name"`
-	Description  types.String                     `tfsdk:"description"`
-	Variables    customtypes.AAPCustomStringValue `tfsdk:"variables"`
+	Id                types.Int64                      `tfsdk:"id"`
+	Organization      types.Int64                      `tfsdk:"organization"`
+	Url               types.String                     `tfsdk:"url"`
+	Name              types.String                     `tfsdk:"name"`
+	Description       types.String                     `tfsdk:"description"`
+	Variables         customtypes.AAPCustomStringValue `tfsdk:"variables"`
+	Kind              types.String                     `tfsdk:"kind"`
+	HostFilter        types.String                     `tfsdk:"host_filter"`
+	InputInventoryIds types.List                       `tfsdk:"input_inventory_ids"`
+	SourceVars        customtypes.AAPCustomStringValue `tfsdk:"source_vars"`
+	Limit             types.String                     `tfsdk:"limit"`
+	InstanceGroups    types.List                       `tfsdk:"instance_groups"`
 }
 
 // generateRequestBody creates a JSON encoded request body from the inventory resource data.
@@ -264,6 +415,19 @@ func (r *inventoryResourceModel) generateRequestBody() ([]byte, diag.Diagnostics
 		Name:         r.Name.ValueString(),
 		Description:  r.Description.ValueString(),
 		Variables:    r.Variables.ValueString(),
+		Kind:         r.Kind.ValueString(),
+	}
+
+	// Send the attributes of smart and constructed inventories even when empty, so that they can be cleared
+	switch inventory.Kind {
+	case inventoryKindSmart:
+		hostFilter := r.HostFilter.ValueString()
+		inventory.HostFilter = &hostFilter
+	case inventoryKindConstructed:
+		sourceVars := r.SourceVars.ValueString()
+		limit := r.Limit.ValueString()
+		inventory.SourceVars = &sourceVars
+		inventory.Limit = &limit
 	}
 
 	// Generate JSON encoded request body
@@ -299,16 +463,231 @@ func (r *inventoryResourceModel) parseHTTPResponse(body []byte) diag.Diagnostics
 	r.Name = types.StringValue(apiInventory.Name)
 	r.Description = ParseStringValue(apiInventory.Description)
 	r.Variables = ParseAAPCustomStringValue(apiInventory.Variables)
+	r.Kind = ParseStringValue(apiInventory.Kind)
+	r.HostFilter = ParseStringPointerValue(apiInventory.HostFilter)
+	r.SourceVars = customtypes.NewAAPCustomStringNull()
+	if apiInventory.SourceVars != nil {
+		r.SourceVars = ParseAAPCustomStringValue(*apiInventory.SourceVars)
+	}
+	r.Limit = ParseStringPointerValue(apiInventory.Limit)
 
 	return parseResponseDiags
 }
 
+// inventoryURL returns the URL of the inventory, constructed inventories are read and updated through their own
+// endpoint so that their source variables and limit are serialized.
+func (r *inventoryResourceModel) inventoryURL() string {
+	if r.Kind.ValueString() == inventoryKindConstructed {
+		return fmt.Sprintf("/api/v2/constructed_inventories/%d/", r.Id.ValueInt64())
+	}
+	return r.Url.ValueString()
+}
+
+// inputInventoriesURL returns the URL of the input inventories association of a constructed inventory.
+func (r *inventoryResourceModel) inputInventoriesURL() string {
+	return fmt.Sprintf("/api/v2/inventories/%d/input_inventories/", r.Id.ValueInt64())
+}
+
+// handleInputInventories associates the input inventories of a constructed inventory in the configured order,
+// then saves them into the inventory resource model.
+func (r *InventoryResource) handleInputInventories(ctx context.Context, data *inventoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Kind.ValueString() == inventoryKindConstructed && IsValueProvided(data.InputInventoryIds) {
+		inputInventories := make([]int64, 0, len(data.InputInventoryIds.Elements()))
+		diags.Append(data.InputInventoryIds.ElementsAs(ctx, &inputInventories, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(reconcileOrderedAssociation(r.client, data.inputInventoriesURL(), inputInventories)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.readInputInventories(ctx, data)...)
+	return diags
+}
+
+// readInputInventories saves the input inventories of a constructed inventory into the inventory resource model.
+func (r *InventoryResource) readInputInventories(ctx context.Context, data *inventoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Kind.ValueString() != inventoryKindConstructed {
+		data.InputInventoryIds = types.ListNull(types.Int64Type)
+		return diags
+	}
+
+	inputInventories, diagsRead := readAssociatedIDs(r.client, data.inputInventoriesURL())
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	inputInventoryIds, diagsConvert := types.ListValueFrom(ctx, types.Int64Type, inputInventories)
+	diags.Append(diagsConvert...)
+	if diags.HasError() {
+		return diags
+	}
+	data.InputInventoryIds = inputInventoryIds
+
+	return diags
+}
+
+// instanceGroupsURL returns the URL of the instance groups association of an inventory.
+func (r *inventoryResourceModel) instanceGroupsURL() string {
+	return fmt.Sprintf("/api/v2/inventories/%d/instance_groups/", r.Id.ValueInt64())
+}
+
+// handleInstanceGroups associates the instance groups from the plan with the inventory, in order,
+// then saves them into the inventory resource model.
+func (r *InventoryResource) handleInstanceGroups(ctx context.Context, data *inventoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if IsValueProvided(data.InstanceGroups) {
+		instanceGroups := make([]int64, 0, len(data.InstanceGroups.Elements()))
+		diags.Append(data.InstanceGroups.ElementsAs(ctx, &instanceGroups, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(reconcileOrderedAssociation(r.client, data.instanceGroupsURL(), instanceGroups)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.readInstanceGroups(ctx, data)...)
+	return diags
+}
+
+// readInstanceGroups saves the instance groups associated with the inventory into the inventory resource model.
+func (r *InventoryResource) readInstanceGroups(ctx context.Context, data *inventoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	instanceGroups, diagsRead := readAssociatedIDs(r.client, data.instanceGroupsURL())
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	instanceGroupIds, diagsConvert := types.ListValueFrom(ctx, types.Int64Type, instanceGroups)
+	diags.Append(diagsConvert...)
+	if diags.HasError() {
+		return diags
+	}
+	data.InstanceGroups = instanceGroupIds
+
+	return diags
+}
+
+// hostFilterTokens splits a smart inventory host filter into terms, operators and parentheses.
+func hostFilterTokens(filter string) ([]string, error) {
+	var tokens []string
+	var token strings.Builder
+	var quote rune
+
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for _, char := range filter {
+		switch {
+		case quote != 0:
+			token.WriteRune(char)
+			if char == quote {
+				quote = 0
+			}
+		case char == '"' || char == '\'':
+			quote = char
+			token.WriteRune(char)
+		case unicode.IsSpace(char):
+			flush()
+		case char == '(' || char == ')':
+			flush()
+			tokens = append(tokens, string(char))
+		default:
+			token.WriteRune(char)
+		}
+	}
+
+	if quote != 0 {
+		return nil, errors.New("unterminated quoted value")
+	}
+	flush()
+
+	return tokens, nil
+}
+
+// validateHostFilter checks the syntax of a smart inventory host filter: field=value terms combined with
+// and, or and not, optionally grouped with parentheses.
+func validateHostFilter(filter string) error {
+	tokens, err := hostFilterTokens(filter)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return errors.New("host filter is empty")
+	}
+
+	depth := 0
+	expectTerm := true
+	for _, token := range tokens {
+		switch token {
+		case "(":
+			if !expectTerm {
+				return fmt.Errorf("unexpected %q", token)
+			}
+			depth++
+		case ")":
+			if expectTerm || depth == 0 {
+				return fmt.Errorf("unexpected %q", token)
+			}
+			depth--
+		case "and", "or":
+			if expectTerm {
+				return fmt.Errorf("unexpected operator %q", token)
+			}
+			expectTerm = true
+		case "not":
+			if !expectTerm {
+				return fmt.Errorf("unexpected operator %q", token)
+			}
+		default:
+			if !expectTerm {
+				return fmt.Errorf("missing operator before %q", token)
+			}
+			if !hostFilterTermRegex.MatchString(token) {
+				return fmt.Errorf("invalid term %q, expected field=value", token)
+			}
+			expectTerm = false
+		}
+	}
+
+	if expectTerm {
+		return errors.New("host filter ends with an operator")
+	}
+	if depth != 0 {
+		return errors.New("unbalanced parentheses")
+	}
+
+	return nil
+}
+
 // Inventory AAP API model
 type InventoryAPIModel struct {
-	Id           int64  `json:"id,omitempty"`
-	Organization int64  `json:"organization"`
-	Url          string `json:"url,omitempty"`
-	Name         string `json:"name"`
-	Description  string `json:"description,omitempty"`
-	Variables    string `json:"variables,omitempty"`
+	Id           int64   `json:"id,omitempty"`
+	Organization int64   `json:"organization"`
+	Url          string  `json:"url,omitempty"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description,omitempty"`
+	Variables    string  `json:"variables,omitempty"`
+	Kind         string  `json:"kind,omitempty"`
+	HostFilter   *string `json:"host_filter,omitempty"`
+	SourceVars   *string `json:"source_vars,omitempty"`
+	Limit        *string `json:"limit,omitempty"`
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -85,6 +86,32 @@ func TestInventoryResourceGenerateRequestBody(t *testing.T) {
 					`"variables":"{\"foo\": \"bar\", \"nested\": {\"foobar\": \"baz\"}}"}`,
 			),
 		},
+		{
+			name: "smart inventory",
+			input: inventoryResourceModel{
+				Organization: types.Int64Value(2),
+				Name:         types.StringValue("test inventory"),
+				Description:  types.StringNull(),
+				Variables:    customtypes.NewAAPCustomStringNull(),
+				Kind:         types.StringValue("smart"),
+				HostFilter:   types.StringValue("name__icontains=web"),
+			},
+			expected: []byte(`{"organization":2,"name":"test inventory","kind":"smart","host_filter":"name__icontains=web"}`),
+		},
+		{
+			name: "constructed inventory",
+			input: inventoryResourceModel{
+				Organization: types.Int64Value(2),
+				Name:         types.StringValue("test inventory"),
+				Description:  types.StringNull(),
+				Variables:    customtypes.NewAAPCustomStringNull(),
+				Kind:         types.StringValue("constructed"),
+				HostFilter:   types.StringNull(),
+				SourceVars:   customtypes.NewAAPCustomStringValue("plugin: constructed"),
+				Limit:        types.StringNull(),
+			},
+			expected: []byte(`{"organization":2,"name":"test inventory","kind":"constructed","source_vars":"plugin: constructed","limit":""}`),
+		},
 	}
 
 	for _, test := range testTable {
@@ -145,6 +172,42 @@ func TestInventoryResourceParseHttpResponse(t *testing.T) {
 			},
 			errors: diag.Diagnostics{},
 		},
+		{
+			name: "smart inventory",
+			input: []byte(`{"id":1,"name":"test inventory","organization":2,"url":"/inventories/1/","kind":"smart",` +
+				`"host_filter":"name__icontains=web"}`),
+			expected: inventoryResourceModel{
+				Id:           types.Int64Value(1),
+				Organization: types.Int64Value(2),
+				Url:          types.StringValue("/inventories/1/"),
+				Name:         types.StringValue("test inventory"),
+				Description:  types.StringNull(),
+				Variables:    customtypes.NewAAPCustomStringNull(),
+				Kind:         types.StringValue("smart"),
+				HostFilter:   types.StringValue("name__icontains=web"),
+				SourceVars:   customtypes.NewAAPCustomStringNull(),
+				Limit:        types.StringNull(),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "constructed inventory",
+			input: []byte(`{"id":1,"name":"test inventory","organization":2,"url":"/api/v2/inventories/1/",` +
+				`"kind":"constructed","host_filter":null,"source_vars":"plugin: constructed","limit":"web"}`),
+			expected: inventoryResourceModel{
+				Id:           types.Int64Value(1),
+				Organization: types.Int64Value(2),
+				Url:          types.StringValue("/api/v2/inventories/1/"),
+				Name:         types.StringValue("test inventory"),
+				Description:  types.StringNull(),
+				Variables:    customtypes.NewAAPCustomStringNull(),
+				Kind:         types.StringValue("constructed"),
+				HostFilter:   types.StringNull(),
+				SourceVars:   customtypes.NewAAPCustomStringValue("plugin: constructed"),
+				Limit:        types.StringValue("web"),
+			},
+			errors: diag.Diagnostics{},
+		},
 	}
 
 	for _, test := range testTable {
@@ -154,13 +217,91 @@ func TestInventoryResourceParseHttpResponse(t *testing.T) {
 			if !test.errors.Equal(diags) {
 				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
 			}
-			if test.expected != resource {
+			if !reflect.DeepEqual(test.expected, resource) {
 				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
 			}
 		})
 	}
 }
 
+func TestInventoryResourceInventoryURL(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    inventoryResourceModel
+		expected string
+	}{
+		{
+			name: "inventory",
+			input: inventoryResourceModel{
+				Id:   types.Int64Value(1),
+				Url:  types.StringValue("/api/v2/inventories/1/"),
+				Kind: types.StringNull(),
+			},
+			expected: "/api/v2/inventories/1/",
+		},
+		{
+			name: "smart inventory",
+			input: inventoryResourceModel{
+				Id:   types.Int64Value(1),
+				Url:  types.StringValue("/api/v2/inventories/1/"),
+				Kind: types.StringValue("smart"),
+			},
+			expected: "/api/v2/inventories/1/",
+		},
+		{
+			name: "constructed inventory",
+			input: inventoryResourceModel{
+				Id:   types.Int64Value(1),
+				Url:  types.StringValue("/api/v2/inventories/1/"),
+				Kind: types.StringValue("constructed"),
+			},
+			expected: "/api/v2/constructed_inventories/1/",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.input.inventoryURL()
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateHostFilter(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "single term", input: "name__icontains=web", expected: ""},
+		{name: "operators", input: "name=web1 or not enabled=false", expected: ""},
+		{name: "groups", input: "(name=web1 or name=web2) and groups__name=prod", expected: ""},
+		{name: "quoted value", input: `ansible_facts__ansible_distribution="Red Hat"`, expected: ""},
+		{name: "empty", input: "  ", expected: "host filter is empty"},
+		{name: "invalid term", input: "name", expected: `invalid term "name", expected field=value`},
+		{name: "missing operator", input: "name=web1 name=web2", expected: `missing operator before "name=web2"`},
+		{name: "leading operator", input: "and name=web1", expected: `unexpected operator "and"`},
+		{name: "trailing operator", input: "name=web1 or", expected: "host filter ends with an operator"},
+		{name: "unbalanced parentheses", input: "(name=web1 or name=web2", expected: "unbalanced parentheses"},
+		{name: "unexpected parenthesis", input: "name=web1)", expected: `unexpected ")"`},
+		{name: "unterminated quote", input: `name="web1`, expected: "unterminated quoted value"},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := ""
+			if err := validateHostFilter(test.input); err != nil {
+				actual = err.Error()
+			}
+			if test.expected != actual {
+				t.Errorf("Expected error (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
 func TestAccInventoryResource(t *testing.T) {
 	var inventory InventoryAPIModel
 	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -207,6 +348,38 @@ func TestAccInventoryResource(t *testing.T) {
 	})
 }
 
+func TestAccInventoryResourceKinds(t *testing.T) {
+	var inventory InventoryAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid host filter testing
+			{
+				Config:      testAccInventoryResourceSmart(randomName, "name__icontains="),
+				ExpectError: regexp.MustCompile("Invalid host filter"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccInventoryResourceSmart(randomName, "name__icontains=web"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInventoryResourceExists("aap_inventory.smart", &inventory),
+					resource.TestCheckResourceAttr("aap_inventory.smart", "kind", "smart"),
+					resource.TestCheckResourceAttr("aap_inventory.smart", "host_filter", "name__icontains=web"),
+					resource.TestCheckResourceAttr("aap_inventory.constructed", "kind", "constructed"),
+					resource.TestCheckResourceAttr("aap_inventory.constructed", "limit", "web"),
+					resource.TestCheckResourceAttr("aap_inventory.constructed", "input_inventory_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("aap_inventory.constructed", "input_inventory_ids.0", "aap_inventory.second", "id"),
+					resource.TestCheckResourceAttrPair("aap_inventory.constructed", "input_inventory_ids.1", "aap_inventory.first", "id"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckInventoryResourceDestroy,
+	})
+}
+
 // testAccInventoryResourceMinimal returns a configuration for an AAP Inventory with the provided name only.
 func testAccInventoryResourceMinimal(name string) string {
 	return fmt.Sprintf(`
@@ -225,6 +398,33 @@ resource "aap_inventory" "test" {
 }`, name)
 }
 
+// testAccInventoryResourceSmart returns a configuration for an AAP smart Inventory with the provided host filter,
+// and a constructed Inventory built from two regular inventories.
+func testAccInventoryResourceSmart(name string, hostFilter string) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "smart" {
+  name        = "%[1]s smart"
+  kind        = "smart"
+  host_filter = "%[2]s"
+}
+
+resource "aap_inventory" "first" {
+  name = "%[1]s first"
+}
+
+resource "aap_inventory" "second" {
+  name = "%[1]s second"
+}
+
+resource "aap_inventory" "constructed" {
+  name                = "%[1]s constructed"
+  kind                = "constructed"
+  input_inventory_ids = [aap_inventory.second.id, aap_inventory.first.id]
+  source_vars         = "plugin: constructed"
+  limit               = "web"
+}`, name, hostFilter)
+}
+
 // testAccInventoryResourceBadVariables returns a configuration for an AAP Inventory with the provided name and invalid variables.
 func testAccInventoryResourceBadVariables(name string) string {
 	return fmt.Sprintf(`
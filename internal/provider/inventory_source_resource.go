@@ -0,0 +1,464 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// inventorySourceTypes are the sources an inventory source can sync hosts from.
+var inventorySourceTypes = []string{
+	"scm", "ec2", "gce", "azure_rm", "vmware", "satellite6", "openstack", "rhv", "controller", "insights",
+}
+
+// Inventory source AAP API model
+type InventorySourceAPIModel struct {
+	Id                 int64  `json:"id,omitempty"`
+	Url                string `json:"url,omitempty"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	Inventory          int64  `json:"inventory"`
+	Source             string `json:"source"`
+	SourceProject      *int64 `json:"source_project"`
+	SourcePath         string `json:"source_path"`
+	SourceVars         string `json:"source_vars"`
+	Credential         *int64 `json:"credential"`
+	Overwrite          bool   `json:"overwrite"`
+	OverwriteVars      bool   `json:"overwrite_vars"`
+	UpdateOnLaunch     bool   `json:"update_on_launch"`
+	UpdateCacheTimeout int64  `json:"update_cache_timeout"`
+}
+
+// InventorySourceResourceModel maps the inventory source resource schema to a Go struct.
+type InventorySourceResourceModel struct {
+	Id                 types.Int64                      `tfsdk:"id"`
+	Url                types.String                     `tfsdk:"url"`
+	Name               types.String                     `tfsdk:"name"`
+	Description        types.String                     `tfsdk:"description"`
+	Inventory          types.Int64                      `tfsdk:"inventory"`
+	Source             types.String                     `tfsdk:"source"`
+	SourceProject      types.Int64                      `tfsdk:"source_project"`
+	SourcePath         types.String                     `tfsdk:"source_path"`
+	SourceVars         customtypes.AAPCustomStringValue `tfsdk:"source_vars"`
+	Credential         types.Int64                      `tfsdk:"credential"`
+	Overwrite          types.Bool                       `tfsdk:"overwrite"`
+	OverwriteVars      types.Bool                       `tfsdk:"overwrite_vars"`
+	UpdateOnLaunch     types.Bool                       `tfsdk:"update_on_launch"`
+	UpdateCacheTimeout types.Int64                      `tfsdk:"update_cache_timeout"`
+	UpdateOnApply      types.Bool                       `tfsdk:"update_on_apply"`
+	HostCount          types.Int64                      `tfsdk:"host_count"`
+}
+
+// InventorySourceResource is the resource implementation.
+type InventorySourceResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &InventorySourceResource{}
+	_ resource.ResourceWithConfigure = &InventorySourceResource{}
+)
+
+// NewInventorySourceResource is a helper function to simplify the provider implementation.
+func NewInventorySourceResource() resource.Resource {
+	return &InventorySourceResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *InventorySourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_source"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *InventorySourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the inventory source resource.
+func (r *InventorySourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Inventory source id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the inventory source",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the inventory source",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the inventory source",
+			},
+			"inventory": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the inventory the inventory source belongs to. Changing it forces a new inventory source.",
+			},
+			"source": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.OneOf(inventorySourceTypes...)},
+				Description: "Type of the inventory source, one of scm, ec2, gce, azure_rm, vmware, satellite6, " +
+					"openstack, rhv, controller or insights.",
+			},
+			"source_project": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the project the inventory file is sourced from. Only used by scm inventory sources.",
+			},
+			"source_path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_project")),
+				},
+				Description: "Path of the inventory file in the source project.",
+			},
+			"source_vars": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+				Description: "Variables passed to the inventory plugin. Must be provided as either a JSON or YAML string.",
+			},
+			"credential": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the cloud credential used to access the inventory source.",
+			},
+			"overwrite": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Remove hosts and groups from the inventory that are no longer present in the source. Defaults to false.",
+			},
+			"overwrite_vars": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Replace variables of the inventory, hosts and groups with the ones from the source. Defaults to false.",
+			},
+			"update_on_launch": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Update the inventory source before each job runs. Defaults to false.",
+			},
+			"update_cache_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Time in seconds a previous inventory update is considered current when update_on_launch is set. Defaults to 0.",
+			},
+			"update_on_apply": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, update the inventory source after each create or update and wait for the update to succeed. Defaults to false.",
+			},
+			"host_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of hosts synced from the inventory source.",
+			},
+		},
+	}
+}
+
+// Create creates the inventory source resource and sets the Terraform state on success.
+func (r *InventorySourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InventorySourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into inventory source resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from inventory source data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new inventory source in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/inventory_sources/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new inventory source data into inventory source resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.UpdateInventorySource(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest inventory source data.
+func (r *InventorySourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InventorySourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into inventory source resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest inventory source data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest inventory source data into inventory source resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadHostCount(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the inventory source resource and sets the updated Terraform state on success.
+func (r *InventorySourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InventorySourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into inventory source resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from inventory source data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update inventory source in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated inventory source data into inventory source resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.UpdateInventorySource(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the inventory source resource.
+func (r *InventorySourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InventorySourceResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into inventory source resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete inventory source from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the inventory source resource data
+func (r *InventorySourceResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert inventory source resource data to API data model
+	inventorySource := InventorySourceAPIModel{
+		Name:               r.Name.ValueString(),
+		Description:        r.Description.ValueString(),
+		Inventory:          r.Inventory.ValueInt64(),
+		Source:             r.Source.ValueString(),
+		SourceProject:      r.SourceProject.ValueInt64Pointer(),
+		SourcePath:         r.SourcePath.ValueString(),
+		SourceVars:         r.SourceVars.ValueString(),
+		Credential:         r.Credential.ValueInt64Pointer(),
+		Overwrite:          r.Overwrite.ValueBool(),
+		OverwriteVars:      r.OverwriteVars.ValueBool(),
+		UpdateOnLaunch:     r.UpdateOnLaunch.ValueBool(),
+		UpdateCacheTimeout: r.UpdateCacheTimeout.ValueInt64(),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(inventorySource)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for inventory source resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHttpResponse updates the inventory source resource data from an AAP API response
+func (r *InventorySourceResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiInventorySource InventorySourceAPIModel
+	err := json.Unmarshal(body, &apiInventorySource)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the inventory source resource schema and update attribute values
+	r.Id = types.Int64Value(apiInventorySource.Id)
+	r.Url = types.StringValue(apiInventorySource.Url)
+	r.Name = types.StringValue(apiInventorySource.Name)
+	r.Description = ParseStringValue(apiInventorySource.Description)
+	r.Inventory = types.Int64Value(apiInventorySource.Inventory)
+	r.Source = types.StringValue(apiInventorySource.Source)
+	r.SourceProject = types.Int64PointerValue(apiInventorySource.SourceProject)
+	r.SourcePath = ParseStringValue(apiInventorySource.SourcePath)
+	r.SourceVars = ParseAAPCustomStringValue(apiInventorySource.SourceVars)
+	r.Credential = types.Int64PointerValue(apiInventorySource.Credential)
+	r.Overwrite = types.BoolValue(apiInventorySource.Overwrite)
+	r.OverwriteVars = types.BoolValue(apiInventorySource.OverwriteVars)
+	r.UpdateOnLaunch = types.BoolValue(apiInventorySource.UpdateOnLaunch)
+	r.UpdateCacheTimeout = types.Int64Value(apiInventorySource.UpdateCacheTimeout)
+
+	return diags
+}
+
+// ParseHostCount updates the number of hosts synced from the inventory source from an AAP API response
+func (r *InventorySourceResourceModel) ParseHostCount(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var hosts listAPIModel
+	err := json.Unmarshal(body, &hosts)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	r.HostCount = types.Int64Value(hosts.Count)
+	return diags
+}
+
+// ReadHostCount saves the number of hosts synced from the inventory source into the inventory source resource model.
+func (r *InventorySourceResource) ReadHostCount(data *InventorySourceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(data.Url.ValueString(), "hosts")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	body, diagsGet := r.client.Get(url + "/?page_size=1")
+	diags.Append(diagsGet...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.ParseHostCount(body)...)
+	return diags
+}
+
+// UpdateInventorySource launches an inventory update and waits for it to succeed when requested,
+// then saves the number of hosts synced from the inventory source into the inventory source resource model.
+func (r *InventorySourceResource) UpdateInventorySource(data *InventorySourceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.UpdateOnApply.ValueBool() {
+		diags.Append(launchUpdateAndWait(r.client, data.Url.ValueString(), "inventory_update", "Inventory update")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadHostCount(data)...)
+	return diags
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestInventorySourceResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the InventorySourceResource and call its Schema method
+	NewInventorySourceResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestInventorySourceResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    InventorySourceResourceModel
+		expected []byte
+	}{
+		{
+			name: "cloud source with defaults",
+			input: InventorySourceResourceModel{
+				Name:               types.StringValue("test source"),
+				Description:        types.StringNull(),
+				Inventory:          types.Int64Value(2),
+				Source:             types.StringValue("ec2"),
+				SourceProject:      types.Int64Null(),
+				SourcePath:         types.StringNull(),
+				SourceVars:         customtypes.NewAAPCustomStringNull(),
+				Credential:         types.Int64Value(4),
+				Overwrite:          types.BoolValue(false),
+				OverwriteVars:      types.BoolValue(false),
+				UpdateOnLaunch:     types.BoolValue(false),
+				UpdateCacheTimeout: types.Int64Value(0),
+			},
+			expected: []byte(`{"name":"test source","description":"","inventory":2,"source":"ec2","source_project":null,` +
+				`"source_path":"","source_vars":"","credential":4,"overwrite":false,"overwrite_vars":false,` +
+				`"update_on_launch":false,"update_cache_timeout":0}`),
+		},
+		{
+			name: "scm source",
+			input: InventorySourceResourceModel{
+				Name:               types.StringValue("test source"),
+				Description:        types.StringValue("A test source"),
+				Inventory:          types.Int64Value(2),
+				Source:             types.StringValue("scm"),
+				SourceProject:      types.Int64Value(6),
+				SourcePath:         types.StringValue("inventories/hosts.yml"),
+				SourceVars:         customtypes.NewAAPCustomStringValue("{\"plugin\": \"constructed\"}"),
+				Credential:         types.Int64Null(),
+				Overwrite:          types.BoolValue(true),
+				OverwriteVars:      types.BoolValue(true),
+				UpdateOnLaunch:     types.BoolValue(true),
+				UpdateCacheTimeout: types.Int64Value(300),
+			},
+			expected: []byte(`{"name":"test source","description":"A test source","inventory":2,"source":"scm","source_project":6,` +
+				`"source_path":"inventories/hosts.yml","source_vars":"{\"plugin\": \"constructed\"}","credential":null,` +
+				`"overwrite":true,"overwrite_vars":true,"update_on_launch":true,"update_cache_timeout":300}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestInventorySourceResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected InventorySourceResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: InventorySourceResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "scm source",
+			input: []byte(`{"id":3,"url":"/api/v2/inventory_sources/3/","name":"test source","description":"","inventory":2,` +
+				`"source":"scm","source_project":6,"source_path":"inventories/hosts.yml","source_vars":"---\nplugin: constructed",` +
+				`"credential":null,"overwrite":true,"overwrite_vars":false,"update_on_launch":true,"update_cache_timeout":300}`),
+			expected: InventorySourceResourceModel{
+				Id:                 types.Int64Value(3),
+				Url:                types.StringValue("/api/v2/inventory_sources/3/"),
+				Name:               types.StringValue("test source"),
+				Description:        types.StringNull(),
+				Inventory:          types.Int64Value(2),
+				Source:             types.StringValue("scm"),
+				SourceProject:      types.Int64Value(6),
+				SourcePath:         types.StringValue("inventories/hosts.yml"),
+				SourceVars:         customtypes.NewAAPCustomStringValue("---\nplugin: constructed"),
+				Credential:         types.Int64Null(),
+				Overwrite:          types.BoolValue(true),
+				OverwriteVars:      types.BoolValue(false),
+				UpdateOnLaunch:     types.BoolValue(true),
+				UpdateCacheTimeout: types.Int64Value(300),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "cloud source",
+			input: []byte(`{"id":4,"url":"/api/v2/inventory_sources/4/","name":"test source","description":"A test source",` +
+				`"inventory":2,"source":"ec2","source_project":null,"source_path":"","source_vars":"","credential":4,` +
+				`"overwrite":false,"overwrite_vars":false,"update_on_launch":false,"update_cache_timeout":0}`),
+			expected: InventorySourceResourceModel{
+				Id:                 types.Int64Value(4),
+				Url:                types.StringValue("/api/v2/inventory_sources/4/"),
+				Name:               types.StringValue("test source"),
+				Description:        types.StringValue("A test source"),
+				Inventory:          types.Int64Value(2),
+				Source:             types.StringValue("ec2"),
+				SourceProject:      types.Int64Null(),
+				SourcePath:         types.StringNull(),
+				SourceVars:         customtypes.NewAAPCustomStringNull(),
+				Credential:         types.Int64Value(4),
+				Overwrite:          types.BoolValue(false),
+				OverwriteVars:      types.BoolValue(false),
+				UpdateOnLaunch:     types.BoolValue(false),
+				UpdateCacheTimeout: types.Int64Value(0),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := InventorySourceResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestInventorySourceResourceParseHostCount(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected types.Int64
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: types.Int64{},
+			errors:   jsonError,
+		},
+		{
+			name:     "no hosts",
+			input:    []byte(`{"count":0,"next":null,"results":[]}`),
+			expected: types.Int64Value(0),
+			errors:   nil,
+		},
+		{
+			name:     "hosts",
+			input:    []byte(`{"count":12,"next":"/api/v2/inventory_sources/3/hosts/?page=2&page_size=1","results":[{"id":1}]}`),
+			expected: types.Int64Value(12),
+			errors:   nil,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := InventorySourceResourceModel{}
+			diags := resource.ParseHostCount(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !test.expected.Equal(resource.HostCount) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource.HostCount)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func testAccInventorySourceResourcePreCheck(t *testing.T) {
+	// Ensure provider requirements
+	testAccPreCheck(t)
+
+	requiredAAPEnvVars := []string{
+		"AAP_TEST_PROJECT_ID",
+		"AAP_TEST_INVENTORY_SOURCE_PATH",
+	}
+
+	for _, key := range requiredAAPEnvVars {
+		if v := os.Getenv(key); v == "" {
+			t.Fatalf("'%s' environment variable must be set when running acceptance tests for inventory source resource", key)
+		}
+	}
+}
+
+func TestAccInventorySourceResource(t *testing.T) {
+	var inventorySource InventorySourceAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectID := os.Getenv("AAP_TEST_PROJECT_ID")
+	sourcePath := os.Getenv("AAP_TEST_INVENTORY_SOURCE_PATH")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccInventorySourceResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInventorySourceResource(randomName, projectID, sourcePath, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInventorySourceResourceExists("aap_inventory_source.test", &inventorySource),
+					resource.TestCheckResourceAttr("aap_inventory_source.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_inventory_source.test", "source", "scm"),
+					resource.TestCheckResourceAttr("aap_inventory_source.test", "source_project", projectID),
+					resource.TestCheckResourceAttr("aap_inventory_source.test", "source_path", sourcePath),
+					resource.TestCheckResourceAttr("aap_inventory_source.test", "host_count", "0"),
+				),
+			},
+			// Update with an inventory update and Read testing
+			{
+				Config: testAccInventorySourceResource(randomName, projectID, sourcePath, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInventorySourceResourceExists("aap_inventory_source.test", &inventorySource),
+					resource.TestCheckResourceAttr("aap_inventory_source.test", "overwrite", "true"),
+					resource.TestCheckResourceAttrWith("aap_inventory_source.test", "host_count", func(value string) error {
+						if value == "0" {
+							return fmt.Errorf("expected hosts to be synced after the inventory update")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckInventorySourceResourceDestroy,
+	})
+}
+
+// testAccInventorySourceResource returns a configuration for an AAP scm Inventory source, optionally updated on apply.
+func testAccInventorySourceResource(name string, projectID string, sourcePath string, update bool) string {
+	return fmt.Sprintf(`
+resource "aap_inventory" "test" {
+  name = "%[1]s"
+}
+
+resource "aap_inventory_source" "test" {
+  name            = "%[1]s"
+  inventory       = aap_inventory.test.id
+  source          = "scm"
+  source_project  = %[2]s
+  source_path     = "%[3]s"
+  overwrite       = %[4]t
+  update_on_apply = %[4]t
+}`, name, projectID, sourcePath, update)
+}
+
+// testAccCheckInventorySourceResourceExists queries the AAP API and retrieves the matching inventory source.
+func testAccCheckInventorySourceResourceExists(name string, inventorySource *InventorySourceAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		inventorySourceResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("inventory source (%s) not found in state", name)
+		}
+
+		inventorySourceResponseBody, err := testGetResource(inventorySourceResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(inventorySourceResponseBody, &inventorySource)
+		if err != nil {
+			return err
+		}
+
+		if inventorySource.Id == 0 {
+			return fmt.Errorf("inventory source (%s) not found in AAP", inventorySourceResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckInventorySourceResourceDestroy verifies the inventory source has been destroyed.
+func testAccCheckInventorySourceResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_inventory_source" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("inventory source (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -6,12 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +25,7 @@ import (
 
 // Job AAP API model
 type JobAPIModel struct {
+	ID            int64                  `json:"id,omitempty"`
 	TemplateID    int64                  `json:"job_template,omitempty"`
 	Type          string                 `json:"job_type,omitempty"`
 	URL           string                 `json:"url,omitempty"`
@@ -26,10 +33,29 @@ type JobAPIModel struct {
 	Inventory     int64                  `json:"inventory,omitempty"`
 	ExtraVars     string                 `json:"extra_vars,omitempty"`
 	IgnoredFields map[string]interface{} `json:"ignored_fields,omitempty"`
+
+	ExecutionEnvironment *int64 `json:"execution_environment,omitempty"`
+}
+
+// Job template launch AAP API model, describing the fields the job template prompts for on launch
+type JobTemplateLaunchAPIModel struct {
+	AskVariablesOnLaunch            bool `json:"ask_variables_on_launch"`
+	AskInventoryOnLaunch            bool `json:"ask_inventory_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool `json:"ask_execution_environment_on_launch"`
+	SurveyEnabled                   bool `json:"survey_enabled"`
+	Defaults                        struct {
+		Inventory struct {
+			ID *int64 `json:"id"`
+		} `json:"inventory"`
+		ExecutionEnvironment struct {
+			ID *int64 `json:"id"`
+		} `json:"execution_environment"`
+	} `json:"defaults"`
 }
 
 // JobResourceModel maps the resource schema data.
 type JobResourceModel struct {
+	ID            types.Int64                      `tfsdk:"id"`
 	TemplateID    types.Int64                      `tfsdk:"job_template_id"`
 	Type          types.String                     `tfsdk:"job_type"`
 	URL           types.String                     `tfsdk:"url"`
@@ -38,6 +64,15 @@ type JobResourceModel struct {
 	ExtraVars     customtypes.AAPCustomStringValue `tfsdk:"extra_vars"`
 	IgnoredFields types.List                       `tfsdk:"ignored_fields"`
 	Triggers      types.Map                        `tfsdk:"triggers"`
+
+	ExecutionEnvironmentID types.Int64 `tfsdk:"execution_environment_id"`
+
+	DestroyTemplateID types.Int64                      `tfsdk:"destroy_job_template_id"`
+	DestroyExtraVars  customtypes.AAPCustomStringValue `tfsdk:"destroy_extra_vars"`
+
+	RelaunchOnFailedHosts types.Bool `tfsdk:"relaunch_on_failed_hosts"`
+	JobHistory            types.List `tfsdk:"job_history"`
+	StrictLaunch          types.Bool `tfsdk:"strict_launch"`
 }
 
 // JobResource is the resource implementation.
@@ -47,14 +82,47 @@ type JobResource struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &JobResource{}
-	_ resource.ResourceWithConfigure = &JobResource{}
+	_ resource.Resource                 = &JobResource{}
+	_ resource.ResourceWithConfigure    = &JobResource{}
+	_ resource.ResourceWithImportState  = &JobResource{}
+	_ resource.ResourceWithModifyPlan   = &JobResource{}
+	_ resource.ResourceWithUpgradeState = &JobResource{}
 )
 
 var keyMapping = map[string]string{
 	"inventory": "inventory",
 }
 
+// Job template flags allowing AAP to accept a field when launching a job.
+var askOnLaunchFlags = map[string]string{
+	"credentials":           "ask_credential_on_launch",
+	"diff_mode":             "ask_diff_mode_on_launch",
+	"execution_environment": "ask_execution_environment_on_launch",
+	"extra_vars":            "ask_variables_on_launch",
+	"forks":                 "ask_forks_on_launch",
+	"instance_groups":       "ask_instance_groups_on_launch",
+	"inventory":             "ask_inventory_on_launch",
+	"job_slice_count":       "ask_job_slice_count_on_launch",
+	"job_tags":              "ask_tags_on_launch",
+	"job_type":              "ask_job_type_on_launch",
+	"labels":                "ask_labels_on_launch",
+	"limit":                 "ask_limit_on_launch",
+	"scm_branch":            "ask_scm_branch_on_launch",
+	"skip_tags":             "ask_skip_tags_on_launch",
+	"timeout":               "ask_timeout_on_launch",
+	"verbosity":             "ask_verbosity_on_launch",
+}
+
+const (
+	jobStatusSuccessful = "successful"
+	jobStatusFailed     = "failed"
+	jobPollInterval     = 5 * time.Second  // Delay between two requests checking the status of a job
+	jobWaitTimeout      = 30 * time.Minute // Maximum time spent waiting for a job to finish
+)
+
+// Job statuses reported by AAP once a job is no longer running.
+var jobFinalStatuses = []string{jobStatusSuccessful, jobStatusFailed, "error", "canceled"}
+
 // NewJobResource is a helper function to simplify the provider implementation.
 func NewJobResource() resource.Resource {
 	return &JobResource{}
@@ -88,7 +156,12 @@ func (r *JobResource) Configure(_ context.Context, req resource.ConfigureRequest
 // Schema defines the schema for the  jobresource.
 func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the job",
+			},
 			"job_template_id": schema.Int64Attribute{
 				Required:    true,
 				Description: "Id of the job template.",
@@ -102,6 +175,11 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description: "Identifier for the inventory where job should be created in. " +
 					"If not provided, the job will be created in the default inventory.",
 			},
+			"execution_environment_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Identifier for the execution environment the job runs in. " +
+					"If not provided, the execution environment of the job template is used.",
+			},
 			"job_type": schema.StringAttribute{
 				Computed:    true,
 				Description: "Job type",
@@ -131,6 +209,36 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Computed:    true,
 				Description: "The list of properties set by the user but ignored on server side.",
 			},
+			"destroy_job_template_id": schema.Int64Attribute{
+				Optional: true,
+				Description: "Id of the job template to launch when the resource is destroyed. " +
+					"The job is launched in the same inventory as the job and Terraform waits for it to finish.",
+			},
+			"destroy_extra_vars": schema.StringAttribute{
+				Description: "Extra Variables for the destroy job. Must be provided as either a JSON or YAML string.",
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+			},
+			"relaunch_on_failed_hosts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When the job has failed, relaunch it on the failed hosts only during the next apply " +
+					"instead of launching a new job from the job template. Defaults to false.",
+			},
+			"strict_launch": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When true, fail without launching the job if the job template does not prompt for any of " +
+					"the fields provided to launch the job, and fail if AAP ignores any of them, instead of only " +
+					"reporting a warning. Defaults to false.",
+			},
+			"job_history": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "URLs of the previous jobs which have been relaunched on their failed hosts, oldest first.",
+			},
 		},
 	}
 }
@@ -149,11 +257,13 @@ func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	// Save updated data into Terraform state
+	// Save updated data into Terraform state, before reporting the ignored fields so that the launched job is tracked
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(data.CheckIgnoredFields()...)
 }
 
 func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -188,46 +298,260 @@ func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state JobResourceModel
+
+	// Read Terraform plan and state data into job resource models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	launched := false
+	switch {
+	case data.RequiresRelaunch(state):
+		// Create new Job from job template
+		resp.Diagnostics.Append(r.LaunchJob(&data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		launched = true
+	case data.ShouldRelaunchFailedHosts(state):
+		// Relaunch the current job on its failed hosts
+		resp.Diagnostics.Append(r.RelaunchFailedHosts(&data, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	default:
+		// Only the destroy or relaunch settings changed, keep the current job
+		data.CopyJobData(state)
+	}
+
+	// Save updated data into Terraform state, before reporting the ignored fields so that the launched job is tracked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if launched {
+		resp.Diagnostics.Append(data.CheckIgnoredFields()...)
+	}
+}
+
+func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data JobResourceModel
 
-	// Read Terraform plan data into job resource model
+	// Read current Terraform state data into job resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Jobs are not deleted from AAP, there is nothing to do without a destroy job template
+	if !IsValueProvided(data.DestroyTemplateID) {
+		return
+	}
+
+	// Launch the destroy job in the same inventory and execution environment as the job
+	destroyJob := JobResourceModel{
+		TemplateID:             data.DestroyTemplateID,
+		InventoryID:            data.InventoryID,
+		ExtraVars:              data.DestroyExtraVars,
+		ExecutionEnvironmentID: data.ExecutionEnvironmentID,
+	}
+	resp.Diagnostics.Append(r.LaunchJob(&destroyJob)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the destroy job to finish
+	status, diags := waitForJob(r.client, destroyJob.URL.ValueString(), jobWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if status != jobStatusSuccessful {
+		resp.Diagnostics.AddError(
+			"Destroy job did not succeed",
+			fmt.Sprintf("Destroy job %s finished with status %s", destroyJob.URL.ValueString(), status),
+		)
+	}
+}
+
+// ModifyPlan plans a relaunch of the failed hosts when the job has failed and relaunch_on_failed_hosts is enabled.
+func (r *JobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state JobResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Create new Job from job template
-	resp.Diagnostics.Append(r.LaunchJob(&data)...)
+	if data.RequiresRelaunch(state) || !data.ShouldRelaunchFailedHosts(state) {
+		return
+	}
+
+	// The attributes of the relaunched job are only known once it has been created
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.Int64Unknown())...)
+	for _, name := range []string{"job_type", "url", "status"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+	for _, name := range []string{"ignored_fields", "job_history"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.ListUnknown(types.StringType))...)
+	}
+}
+
+// ImportState imports an existing job from its id.
+func (r *JobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the numeric id of a job, got: %s", req.ID),
+		)
+		return
+	}
+
+	data := JobResourceModel{
+		Triggers:              types.MapNull(types.StringType),
+		RelaunchOnFailedHosts: types.BoolValue(false),
+		JobHistory:            types.ListNull(types.StringType),
+		StrictLaunch:          types.BoolValue(false),
+	}
+
+	// Get job data from AAP
+	readResponseBody, diags := r.client.Get(fmt.Sprintf("/api/v2/jobs/%d/", id))
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Save updated data into Terraform state
+	// Save job data into job resource model
+	resp.Diagnostics.Append(data.ParseHttpResponse(readResponseBody)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save imported data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-func (r JobResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+// UpgradeState upgrades job resource states created with previous schema versions.
+func (r *JobResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 did not have the job id
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"job_template_id":         schema.Int64Attribute{Required: true},
+					"inventory_id":            schema.Int64Attribute{Optional: true, Computed: true},
+					"job_type":                schema.StringAttribute{Computed: true},
+					"url":                     schema.StringAttribute{Computed: true},
+					"status":                  schema.StringAttribute{Computed: true},
+					"extra_vars":              schema.StringAttribute{Optional: true, CustomType: customtypes.AAPCustomStringType{}},
+					"triggers":                schema.MapAttribute{Optional: true, ElementType: types.StringType},
+					"ignored_fields":          schema.ListAttribute{Computed: true, ElementType: types.StringType},
+					"destroy_job_template_id": schema.Int64Attribute{Optional: true},
+					"destroy_extra_vars":      schema.StringAttribute{Optional: true, CustomType: customtypes.AAPCustomStringType{}},
+				},
+			},
+			StateUpgrader: upgradeJobStateV0,
+		},
+	}
 }
 
-// CreateRequestBody creates a JSON encoded request body from the job resource data
-func (r *JobResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+// jobResourceModelV0 maps the version 0 of the job resource schema.
+type jobResourceModelV0 struct {
+	TemplateID        types.Int64                      `tfsdk:"job_template_id"`
+	Type              types.String                     `tfsdk:"job_type"`
+	URL               types.String                     `tfsdk:"url"`
+	Status            types.String                     `tfsdk:"status"`
+	InventoryID       types.Int64                      `tfsdk:"inventory_id"`
+	ExtraVars         customtypes.AAPCustomStringValue `tfsdk:"extra_vars"`
+	IgnoredFields     types.List                       `tfsdk:"ignored_fields"`
+	Triggers          types.Map                        `tfsdk:"triggers"`
+	DestroyTemplateID types.Int64                      `tfsdk:"destroy_job_template_id"`
+	DestroyExtraVars  customtypes.AAPCustomStringValue `tfsdk:"destroy_extra_vars"`
+}
+
+// upgradeJobStateV0 derives the job id from the job URL stored in a version 0 state. The job id is null when no
+// job URL was stored.
+func upgradeJobStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var priorData jobResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := types.Int64Null()
+	if priorData.URL.ValueString() != "" {
+		jobID, diags := jobIDFromURL(priorData.URL.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = types.Int64Value(jobID)
+	}
+
+	data := JobResourceModel{
+		ID:                id,
+		TemplateID:        priorData.TemplateID,
+		Type:              priorData.Type,
+		URL:               priorData.URL,
+		Status:            priorData.Status,
+		InventoryID:       priorData.InventoryID,
+		ExtraVars:         priorData.ExtraVars,
+		IgnoredFields:     priorData.IgnoredFields,
+		Triggers:          priorData.Triggers,
+		DestroyTemplateID: priorData.DestroyTemplateID,
+		DestroyExtraVars:  priorData.DestroyExtraVars,
+
+		ExecutionEnvironmentID: types.Int64Null(),
+
+		RelaunchOnFailedHosts: types.BoolValue(false),
+		JobHistory:            types.ListNull(types.StringType),
+		StrictLaunch:          types.BoolValue(false),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// jobIDFromURL extracts the job id from a job URL such as /api/v2/jobs/14/.
+func jobIDFromURL(url string) (int64, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var inventoryID int64
 
-	// Use default inventory if not provided
-	if r.InventoryID.ValueInt64() == 0 {
-		inventoryID = 1
-	} else {
-		inventoryID = r.InventoryID.ValueInt64()
+	trimmedURL := strings.TrimSuffix(url, "/")
+	id, err := strconv.ParseInt(trimmedURL[strings.LastIndex(trimmedURL, "/")+1:], 10, 64)
+	if err != nil {
+		diags.AddError(
+			"Error parsing job URL",
+			fmt.Sprintf("Could not extract the job id from URL %s, unexpected error: %s", url, err.Error()),
+		)
+		return 0, diags
 	}
 
+	return id, diags
+}
+
+// CreateRequestBody creates a JSON encoded request body from the job resource data
+func (r *JobResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	// Convert job resource data to API data model
 	job := JobAPIModel{
-		ExtraVars: r.ExtraVars.ValueString(),
-		Inventory: inventoryID,
+		ExtraVars:            r.ExtraVars.ValueString(),
+		Inventory:            r.LaunchInventoryID(),
+		ExecutionEnvironment: r.ExecutionEnvironmentID.ValueInt64Pointer(),
 	}
 
 	// Create JSON encoded request body
@@ -242,6 +566,14 @@ func (r *JobResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
 	return jsonBody, diags
 }
 
+// LaunchInventoryID returns the id of the inventory the job is launched in, the default inventory if not provided.
+func (r *JobResourceModel) LaunchInventoryID() int64 {
+	if r.InventoryID.ValueInt64() == 0 {
+		return 1
+	}
+	return r.InventoryID.ValueInt64()
+}
+
 // ParseHttpResponse updates the job resource data from an AAP API response
 func (r *JobResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -255,6 +587,7 @@ func (r *JobResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
 	}
 
 	// Map response to the job resource schema and update attribute values
+	r.ID = types.Int64Value(resultApiJob.ID)
 	r.Type = types.StringValue(resultApiJob.Type)
 	r.URL = types.StringValue(resultApiJob.URL)
 	r.Status = types.StringValue(resultApiJob.Status)
@@ -287,6 +620,27 @@ func (r *JobResource) LaunchJob(data *JobResourceModel) diag.Diagnostics {
 	// Create new Job from job template
 	var diags diag.Diagnostics
 
+	// In strict launch mode, fail before launching a job which would ignore some of the provided fields
+	if data.StrictLaunch.ValueBool() {
+		launchResponseBody, diagsGet := r.client.Get("/api/v2/job_templates/" + data.GetTemplateID() + "/launch/")
+		diags.Append(diagsGet...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var launch JobTemplateLaunchAPIModel
+		err := json.Unmarshal(launchResponseBody, &launch)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return diags
+		}
+
+		diags.Append(data.CheckLaunchPrompts(launch)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	// Create request body from job data
 	requestBody, diagCreateReq := data.CreateRequestBody()
 	diags.Append(diagCreateReq...)
@@ -308,9 +662,177 @@ func (r *JobResource) LaunchJob(data *JobResourceModel) diag.Diagnostics {
 		return diags
 	}
 
+	// A job launched from the job template starts a new history
+	data.JobHistory = types.ListNull(types.StringType)
+
+	return diags
+}
+
+// CheckLaunchPrompts reports each provided field the job template does not prompt for on launch, and which AAP
+// would therefore ignore. As AAP does, fields matching the value of the job template are not reported.
+func (r *JobResourceModel) CheckLaunchPrompts(launch JobTemplateLaunchAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var fields []string
+
+	if IsValueProvided(r.ExecutionEnvironmentID) && !launch.AskExecutionEnvironmentOnLaunch &&
+		!r.ExecutionEnvironmentID.Equal(types.Int64PointerValue(launch.Defaults.ExecutionEnvironment.ID)) {
+		fields = append(fields, "execution_environment")
+	}
+	if r.ExtraVars.ValueString() != "" && !launch.AskVariablesOnLaunch && !launch.SurveyEnabled {
+		fields = append(fields, "extra_vars")
+	}
+	if !launch.AskInventoryOnLaunch && (launch.Defaults.Inventory.ID == nil || *launch.Defaults.Inventory.ID != r.LaunchInventoryID()) {
+		fields = append(fields, "inventory")
+	}
+
+	for _, field := range fields {
+		diags.AddError(
+			"Field ignored when launching job",
+			fmt.Sprintf("AAP would ignore the %s field when launching a job from job template %d."+
+				" Enable %s on the job template to provide it on launch.",
+				field, r.TemplateID.ValueInt64(), askOnLaunchFlags[field]),
+		)
+	}
+
+	return diags
+}
+
+// CheckIgnoredFields reports each field ignored by AAP when launching the job, along with the job template
+// flag which would allow it. Ignored fields are errors in strict launch mode and warnings otherwise.
+func (r *JobResourceModel) CheckIgnoredFields() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !IsValueProvided(r.IgnoredFields) {
+		return diags
+	}
+
+	fields := make([]string, 0, len(r.IgnoredFields.Elements()))
+	diags.Append(r.IgnoredFields.ElementsAs(context.Background(), &fields, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		summary := "Field ignored when launching job"
+		detail := fmt.Sprintf("AAP ignored the %s field when launching job %s from job template %d.",
+			field, r.URL.ValueString(), r.TemplateID.ValueInt64())
+		if flag, ok := askOnLaunchFlags[field]; ok {
+			detail += fmt.Sprintf(" Enable %s on the job template to provide it on launch.", flag)
+		}
+
+		if r.StrictLaunch.ValueBool() {
+			diags.AddError(summary, detail)
+		} else {
+			diags.AddWarning(summary, detail)
+		}
+	}
+
 	return diags
 }
 
 func (r *JobResourceModel) GetTemplateID() string {
 	return r.TemplateID.String()
 }
+
+// RequiresRelaunch returns true when the configuration used to launch the job differs from the provided state.
+func (r *JobResourceModel) RequiresRelaunch(state JobResourceModel) bool {
+	return !r.TemplateID.Equal(state.TemplateID) ||
+		!r.InventoryID.Equal(state.InventoryID) ||
+		!r.ExtraVars.Equal(state.ExtraVars) ||
+		!r.ExecutionEnvironmentID.Equal(state.ExecutionEnvironmentID) ||
+		!r.Triggers.Equal(state.Triggers)
+}
+
+// CopyJobData sets the job attributes computed by AAP from the provided state.
+func (r *JobResourceModel) CopyJobData(state JobResourceModel) {
+	r.ID = state.ID
+	r.Type = state.Type
+	r.URL = state.URL
+	r.Status = state.Status
+	r.IgnoredFields = state.IgnoredFields
+	r.JobHistory = state.JobHistory
+}
+
+// ShouldRelaunchFailedHosts returns true when the job from the provided state has failed and
+// relaunching the failed hosts is enabled.
+func (r *JobResourceModel) ShouldRelaunchFailedHosts(state JobResourceModel) bool {
+	return r.RelaunchOnFailedHosts.ValueBool() && state.Status.ValueString() == jobStatusFailed
+}
+
+// RelaunchFailedHosts relaunches the job from the provided state on its failed hosts, and tracks
+// the new job as the current one while recording the previous job URL in the job history.
+func (r *JobResource) RelaunchFailedHosts(data *JobResourceModel, state JobResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	requestBody, err := json.Marshal(map[string]string{"hosts": "failed"})
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for job relaunch, unexpected error: %s", err.Error()),
+		)
+		return diags
+	}
+
+	requestData := bytes.NewReader(requestBody)
+	var postURL = fmt.Sprintf("/api/v2/jobs/%d/relaunch/", state.ID.ValueInt64())
+	resp, body, err := r.client.doRequest(http.MethodPost, postURL, requestData)
+	diags.Append(ValidateResponse(resp, body, err, []int{http.StatusCreated})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Save relaunched job data into job resource model
+	diags.Append(data.ParseHttpResponse(body)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	history := []attr.Value{}
+	if IsValueProvided(state.JobHistory) {
+		history = append(history, state.JobHistory.Elements()...)
+	}
+	history = append(history, state.URL)
+
+	jobHistory, diagsHistory := types.ListValue(types.StringType, history)
+	diags.Append(diagsHistory...)
+	data.JobHistory = jobHistory
+
+	return diags
+}
+
+// waitForJob polls the job at the provided URL until it reaches a final status or the timeout expires,
+// and returns the last status reported by AAP.
+func waitForJob(client ProviderHTTPClient, url string, timeout time.Duration) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	deadline := time.Now().Add(timeout)
+
+	for {
+		body, diagsGet := client.Get(url)
+		diags.Append(diagsGet...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		var job JobAPIModel
+		err := json.Unmarshal(body, &job)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return "", diags
+		}
+
+		if slices.Contains(jobFinalStatuses, job.Status) {
+			return job.Status, diags
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				"Timeout waiting for job",
+				fmt.Sprintf("Job %s did not finish within %s, last status was %s", url, timeout, job.Status),
+			)
+			return job.Status, diags
+		}
+
+		time.Sleep(jobPollInterval)
+	}
+}
@@ -4,17 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -97,6 +101,14 @@ func TestJobResourceCreateRequestBody(t *testing.T) {
 			},
 			expected: []byte(`{"inventory": 3}`),
 		},
+		{
+			name: "execution environment",
+			input: JobResourceModel{
+				InventoryID:            basetypes.NewInt64Value(3),
+				ExecutionEnvironmentID: basetypes.NewInt64Value(4),
+			},
+			expected: []byte(`{"inventory":3,"execution_environment":4}`),
+		},
 	}
 
 	for _, tc := range testTable {
@@ -149,8 +161,9 @@ func TestJobResourceParseHttpResponse(t *testing.T) {
 		},
 		{
 			name:  "no ignored fields",
-			input: []byte(`{"inventory":2,"job_template":1,"job_type": "run", "url": "/api/v2/jobs/14/", "status": "pending"}`),
+			input: []byte(`{"id":14,"inventory":2,"job_template":1,"job_type": "run", "url": "/api/v2/jobs/14/", "status": "pending"}`),
 			expected: JobResourceModel{
+				ID:            types.Int64Value(14),
 				TemplateID:    templateID,
 				Type:          types.StringValue("run"),
 				URL:           types.StringValue("/api/v2/jobs/14/"),
@@ -163,9 +176,10 @@ func TestJobResourceParseHttpResponse(t *testing.T) {
 		},
 		{
 			name: "ignored fields",
-			input: []byte(`{"inventory":2,"job_template":1,"job_type": "run", "url": "/api/v2/jobs/14/", "status":
+			input: []byte(`{"id":14,"inventory":2,"job_template":1,"job_type": "run", "url": "/api/v2/jobs/14/", "status":
 			"pending", "ignored_fields": {"extra_vars": "{\"bucket_state\":\"absent\"}"}}`),
 			expected: JobResourceModel{
+				ID:            types.Int64Value(14),
 				TemplateID:    templateID,
 				Type:          types.StringValue("run"),
 				URL:           types.StringValue("/api/v2/jobs/14/"),
@@ -192,6 +206,412 @@ func TestJobResourceParseHttpResponse(t *testing.T) {
 	}
 }
 
+func TestJobResourceCheckIgnoredFields(t *testing.T) {
+	ignoredFields := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("inventory"),
+		types.StringValue("extra_vars"),
+	})
+	detailExtraVars := "AAP ignored the extra_vars field when launching job /api/v2/jobs/14/ from job template 1." +
+		" Enable ask_variables_on_launch on the job template to provide it on launch."
+	detailInventory := "AAP ignored the inventory field when launching job /api/v2/jobs/14/ from job template 1." +
+		" Enable ask_inventory_on_launch on the job template to provide it on launch."
+
+	warnings := diag.Diagnostics{}
+	warnings.AddWarning("Field ignored when launching job", detailExtraVars)
+	warnings.AddWarning("Field ignored when launching job", detailInventory)
+	errors := diag.Diagnostics{}
+	errors.AddError("Field ignored when launching job", detailExtraVars)
+	errors.AddError("Field ignored when launching job", detailInventory)
+
+	var testTable = []struct {
+		name          string
+		ignoredFields types.List
+		strictLaunch  types.Bool
+		expected      diag.Diagnostics
+	}{
+		{"no ignored fields", types.ListNull(types.StringType), types.BoolValue(true), diag.Diagnostics{}},
+		{"warnings", ignoredFields, types.BoolValue(false), warnings},
+		{"strict launch not set", ignoredFields, types.BoolNull(), warnings},
+		{"strict launch", ignoredFields, types.BoolValue(true), errors},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := JobResourceModel{
+				TemplateID:    types.Int64Value(1),
+				URL:           types.StringValue("/api/v2/jobs/14/"),
+				IgnoredFields: test.ignoredFields,
+				StrictLaunch:  test.strictLaunch,
+			}
+			diags := resource.CheckIgnoredFields()
+			if !test.expected.Equal(diags) {
+				t.Errorf("Expected diagnostics (%s), actual were (%s)", test.expected, diags)
+			}
+		})
+	}
+}
+
+func TestJobResourceCheckLaunchPrompts(t *testing.T) {
+	var inventoryID, executionEnvironmentID int64 = 2, 3
+	defaults := JobTemplateLaunchAPIModel{}
+	defaults.Defaults.Inventory.ID = &inventoryID
+	defaults.Defaults.ExecutionEnvironment.ID = &executionEnvironmentID
+
+	prompted := defaults
+	prompted.AskVariablesOnLaunch = true
+	prompted.AskInventoryOnLaunch = true
+	prompted.AskExecutionEnvironmentOnLaunch = true
+
+	withSurvey := defaults
+	withSurvey.SurveyEnabled = true
+
+	var testTable = []struct {
+		name     string
+		input    JobResourceModel
+		launch   JobTemplateLaunchAPIModel
+		expected []string
+	}{
+		{
+			name: "values of the job template",
+			input: JobResourceModel{
+				InventoryID:            types.Int64Value(2),
+				ExecutionEnvironmentID: types.Int64Value(3),
+				ExtraVars:              customtypes.NewAAPCustomStringNull(),
+			},
+			launch:   defaults,
+			expected: nil,
+		},
+		{
+			name: "fields not prompted on launch",
+			input: JobResourceModel{
+				InventoryID:            types.Int64Value(4),
+				ExecutionEnvironmentID: types.Int64Value(5),
+				ExtraVars:              customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+			},
+			launch:   defaults,
+			expected: []string{"execution_environment", "extra_vars", "inventory"},
+		},
+		{
+			name: "fields prompted on launch",
+			input: JobResourceModel{
+				InventoryID:            types.Int64Value(4),
+				ExecutionEnvironmentID: types.Int64Value(5),
+				ExtraVars:              customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+			},
+			launch:   prompted,
+			expected: nil,
+		},
+		{
+			name: "extra vars answering the survey",
+			input: JobResourceModel{
+				InventoryID:            types.Int64Unknown(),
+				ExecutionEnvironmentID: types.Int64Null(),
+				ExtraVars:              customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+			},
+			launch:   withSurvey,
+			expected: []string{"inventory"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			test.input.TemplateID = types.Int64Value(1)
+			expected := diag.Diagnostics{}
+			for _, field := range test.expected {
+				expected.AddError("Field ignored when launching job", fmt.Sprintf(
+					"AAP would ignore the %s field when launching a job from job template 1."+
+						" Enable %s on the job template to provide it on launch.", field, askOnLaunchFlags[field]))
+			}
+
+			diags := test.input.CheckLaunchPrompts(test.launch)
+			if !expected.Equal(diags) {
+				t.Errorf("Expected diagnostics (%s), actual were (%s)", expected, diags)
+			}
+		})
+	}
+}
+
+func TestJobResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	upgrader := NewJobResource().(*JobResource).UpgradeState(ctx)[0]
+
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewJobResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	var testTable = []struct {
+		name     string
+		url      types.String
+		expected types.Int64
+	}{
+		{"job URL", types.StringValue("/api/v2/jobs/14/"), types.Int64Value(14)},
+		{"no job URL", types.StringNull(), types.Int64Null()},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			priorState := tfsdk.State{
+				Schema: *upgrader.PriorSchema,
+				Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+			}
+			priorData := jobResourceModelV0{
+				TemplateID:        types.Int64Value(1),
+				Type:              types.StringValue("run"),
+				URL:               test.url,
+				Status:            types.StringValue("successful"),
+				InventoryID:       types.Int64Value(2),
+				ExtraVars:         customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+				IgnoredFields:     types.ListNull(types.StringType),
+				Triggers:          types.MapNull(types.StringType),
+				DestroyTemplateID: types.Int64Null(),
+				DestroyExtraVars:  customtypes.NewAAPCustomStringNull(),
+			}
+			diags := priorState.Set(ctx, &priorData)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+
+			req := fwresource.UpgradeStateRequest{State: &priorState}
+			resp := &fwresource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResponse.Schema,
+					Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			upgrader.StateUpgrader(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics.Errors())
+			}
+
+			var actual JobResourceModel
+			diags = resp.State.Get(ctx, &actual)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+
+			expected := JobResourceModel{
+				ID:                     test.expected,
+				TemplateID:             priorData.TemplateID,
+				Type:                   priorData.Type,
+				URL:                    priorData.URL,
+				Status:                 priorData.Status,
+				InventoryID:            priorData.InventoryID,
+				ExtraVars:              priorData.ExtraVars,
+				IgnoredFields:          priorData.IgnoredFields,
+				Triggers:               priorData.Triggers,
+				ExecutionEnvironmentID: types.Int64Null(),
+				DestroyTemplateID:      priorData.DestroyTemplateID,
+				DestroyExtraVars:       priorData.DestroyExtraVars,
+				RelaunchOnFailedHosts:  types.BoolValue(false),
+				JobHistory:             types.ListNull(types.StringType),
+				StrictLaunch:           types.BoolValue(false),
+			}
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", expected, actual)
+			}
+		})
+	}
+}
+
+func TestJobIDFromURL(t *testing.T) {
+	var testTable = []struct {
+		url         string
+		expected    int64
+		expectError bool
+	}{
+		{"/api/v2/jobs/14/", 14, false},
+		{"/api/v2/jobs/14", 14, false},
+		{"https://localhost:8043/api/v2/jobs/1234/", 1234, false},
+		{"/api/v2/jobs/", 0, true},
+		{"", 0, true},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.url, func(t *testing.T) {
+			id, diags := jobIDFromURL(test.url)
+			if test.expectError != diags.HasError() {
+				t.Errorf("Expected error (%t), actual diagnostics were (%s)", test.expectError, diags)
+			}
+			if id != test.expected {
+				t.Errorf("Expected (%d) not equal to actual (%d)", test.expected, id)
+			}
+		})
+	}
+}
+
+func TestJobResourceRequiresRelaunch(t *testing.T) {
+	state := JobResourceModel{
+		TemplateID:        types.Int64Value(1),
+		InventoryID:       types.Int64Value(2),
+		ExtraVars:         customtypes.NewAAPCustomStringValue("{\"foo\":\"bar\"}"),
+		Triggers:          types.MapNull(types.StringType),
+		DestroyTemplateID: types.Int64Null(),
+		DestroyExtraVars:  customtypes.NewAAPCustomStringNull(),
+	}
+
+	var testTable = []struct {
+		name     string
+		update   func(plan *JobResourceModel)
+		expected bool
+	}{
+		{
+			name:     "no changes",
+			update:   func(_ *JobResourceModel) {},
+			expected: false,
+		},
+		{
+			name: "destroy settings only",
+			update: func(plan *JobResourceModel) {
+				plan.DestroyTemplateID = types.Int64Value(3)
+				plan.DestroyExtraVars = customtypes.NewAAPCustomStringValue("{\"state\":\"absent\"}")
+			},
+			expected: false,
+		},
+		{
+			name: "new inventory",
+			update: func(plan *JobResourceModel) {
+				plan.InventoryID = types.Int64Value(3)
+			},
+			expected: true,
+		},
+		{
+			name: "new extra vars",
+			update: func(plan *JobResourceModel) {
+				plan.ExtraVars = customtypes.NewAAPCustomStringNull()
+			},
+			expected: true,
+		},
+		{
+			name: "new execution environment",
+			update: func(plan *JobResourceModel) {
+				plan.ExecutionEnvironmentID = types.Int64Value(4)
+			},
+			expected: true,
+		},
+		{
+			name: "new triggers",
+			update: func(plan *JobResourceModel) {
+				plan.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("value")})
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			plan := state
+			test.update(&plan)
+			if actual := plan.RequiresRelaunch(state); actual != test.expected {
+				t.Errorf("Expected (%t) not equal to actual (%t)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestJobResourceShouldRelaunchFailedHosts(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		enabled  types.Bool
+		status   string
+		expected bool
+	}{
+		{"failed job", types.BoolValue(true), "failed", true},
+		{"successful job", types.BoolValue(true), "successful", false},
+		{"running job", types.BoolValue(true), "running", false},
+		{"disabled", types.BoolValue(false), "failed", false},
+		{"not set", types.BoolNull(), "failed", false},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			plan := JobResourceModel{RelaunchOnFailedHosts: test.enabled}
+			state := JobResourceModel{Status: types.StringValue(test.status)}
+			if actual := plan.ShouldRelaunchFailedHosts(state); actual != test.expected {
+				t.Errorf("Expected (%t) not equal to actual (%t)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestJobResourceRelaunchFailedHosts(t *testing.T) {
+	r := JobResource{client: NewMockHTTPClient([]string{"POST"}, http.StatusCreated)}
+
+	var testTable = []struct {
+		name     string
+		history  types.List
+		expected types.List
+	}{
+		{
+			name:     "first relaunch",
+			history:  types.ListNull(types.StringType),
+			expected: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("/api/v2/jobs/4/")}),
+		},
+		{
+			name:    "second relaunch",
+			history: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("/api/v2/jobs/3/")}),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("/api/v2/jobs/3/"),
+				types.StringValue("/api/v2/jobs/4/"),
+			}),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			state := JobResourceModel{
+				ID:         types.Int64Value(4),
+				URL:        types.StringValue("/api/v2/jobs/4/"),
+				Status:     types.StringValue("failed"),
+				JobHistory: test.history,
+			}
+			data := JobResourceModel{RelaunchOnFailedHosts: types.BoolValue(true)}
+
+			diags := r.RelaunchFailedHosts(&data, state)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if data.URL.ValueString() != "/api/v2/jobs/5/" {
+				t.Errorf("Expected relaunched job URL (/api/v2/jobs/5/), actual was (%s)", data.URL.ValueString())
+			}
+			if !test.expected.Equal(data.JobHistory) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, data.JobHistory)
+			}
+		})
+	}
+}
+
+func TestWaitForJob(t *testing.T) {
+	client := NewMockHTTPClient([]string{"GET"}, http.StatusOK)
+
+	var testTable = []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{
+			name:     "successful job",
+			url:      "/api/v2/jobs/3/",
+			expected: "successful",
+		},
+		{
+			name:     "failed job",
+			url:      "/api/v2/jobs/4/",
+			expected: "failed",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			status, diags := waitForJob(client, test.url, time.Minute)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if status != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, status)
+			}
+		})
+	}
+}
+
 // Acceptance tests
 
 func getJobResourceFromStateFile(s *terraform.State) (map[string]interface{}, error) {
@@ -380,6 +800,53 @@ func TestAccAAPJob_UpdateWithTrigger(t *testing.T) {
 	})
 }
 
+func TestAccAAPJob_Import(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBasicJob(jobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("^[0-9]+$")),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("^/api/v2/jobs/[0-9]*/$")),
+					testAccCheckJobExists,
+				),
+			},
+			// Import testing, the job status may have changed since the creation
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+	})
+}
+
+func TestAccAAPJob_DestroyJob(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the destroy job is launched when the test resources are destroyed
+			{
+				Config: testAccJobWithDestroyJob(jobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destroy_job_template_id", jobTemplateID),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("^/api/v2/jobs/[0-9]*/$")),
+					testAccCheckJobExists,
+				),
+			},
+		},
+	})
+}
+
 func testAccBasicJob(jobTemplateID string) string {
 	return fmt.Sprintf(`
 resource "aap_job" "test" {
@@ -408,3 +875,13 @@ resource "aap_job" "test" {
 }
 `, jobTemplateID)
 }
+
+func testAccJobWithDestroyJob(jobTemplateID string) string {
+	return fmt.Sprintf(`
+resource "aap_job" "test" {
+	job_template_id         = %s
+	destroy_job_template_id = %s
+	destroy_extra_vars      = jsonencode({ "resource_state" : "absent" })
+}
+`, jobTemplateID, jobTemplateID)
+}
@@ -0,0 +1,699 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const maxJobVerbosity = 5
+
+// Job template AAP API model
+type JobTemplateAPIModel struct {
+	Id                   int64  `json:"id,omitempty"`
+	Url                  string `json:"url,omitempty"`
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	JobType              string `json:"job_type,omitempty"`
+	Project              int64  `json:"project"`
+	Playbook             string `json:"playbook"`
+	ScmBranch            string `json:"scm_branch"`
+	Inventory            *int64 `json:"inventory"`
+	ExecutionEnvironment *int64 `json:"execution_environment"`
+	Forks                int64  `json:"forks"`
+	Limit                string `json:"limit"`
+	Verbosity            int64  `json:"verbosity"`
+	Timeout              int64  `json:"timeout"`
+	JobTags              string `json:"job_tags"`
+	SkipTags             string `json:"skip_tags"`
+	ExtraVars            string `json:"extra_vars"`
+	BecomeEnabled        bool   `json:"become_enabled"`
+	DiffMode             bool   `json:"diff_mode"`
+	AllowSimultaneous    bool   `json:"allow_simultaneous"`
+	WebhookService       string `json:"webhook_service"`
+	WebhookCredential    *int64 `json:"webhook_credential"`
+
+	AskCredentialOnLaunch           bool `json:"ask_credential_on_launch"`
+	AskDiffModeOnLaunch             bool `json:"ask_diff_mode_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool `json:"ask_execution_environment_on_launch"`
+	AskVariablesOnLaunch            bool `json:"ask_variables_on_launch"`
+	AskForksOnLaunch                bool `json:"ask_forks_on_launch"`
+	AskInstanceGroupsOnLaunch       bool `json:"ask_instance_groups_on_launch"`
+	AskInventoryOnLaunch            bool `json:"ask_inventory_on_launch"`
+	AskJobSliceCountOnLaunch        bool `json:"ask_job_slice_count_on_launch"`
+	AskTagsOnLaunch                 bool `json:"ask_tags_on_launch"`
+	AskJobTypeOnLaunch              bool `json:"ask_job_type_on_launch"`
+	AskLabelsOnLaunch               bool `json:"ask_labels_on_launch"`
+	AskLimitOnLaunch                bool `json:"ask_limit_on_launch"`
+	AskScmBranchOnLaunch            bool `json:"ask_scm_branch_on_launch"`
+	AskSkipTagsOnLaunch             bool `json:"ask_skip_tags_on_launch"`
+	AskTimeoutOnLaunch              bool `json:"ask_timeout_on_launch"`
+	AskVerbosityOnLaunch            bool `json:"ask_verbosity_on_launch"`
+}
+
+// JobTemplateResourceModel maps the job template resource schema to a Go struct.
+type JobTemplateResourceModel struct {
+	Id                   types.Int64                      `tfsdk:"id"`
+	Url                  types.String                     `tfsdk:"url"`
+	Name                 types.String                     `tfsdk:"name"`
+	Description          types.String                     `tfsdk:"description"`
+	JobType              types.String                     `tfsdk:"job_type"`
+	Project              types.Int64                      `tfsdk:"project"`
+	Playbook             types.String                     `tfsdk:"playbook"`
+	ScmBranch            types.String                     `tfsdk:"scm_branch"`
+	Inventory            types.Int64                      `tfsdk:"inventory"`
+	ExecutionEnvironment types.Int64                      `tfsdk:"execution_environment"`
+	Forks                types.Int64                      `tfsdk:"forks"`
+	Limit                types.String                     `tfsdk:"limit"`
+	Verbosity            types.Int64                      `tfsdk:"verbosity"`
+	Timeout              types.Int64                      `tfsdk:"timeout"`
+	JobTags              types.String                     `tfsdk:"job_tags"`
+	SkipTags             types.String                     `tfsdk:"skip_tags"`
+	ExtraVars            customtypes.AAPCustomStringValue `tfsdk:"extra_vars"`
+	BecomeEnabled        types.Bool                       `tfsdk:"become_enabled"`
+	DiffMode             types.Bool                       `tfsdk:"diff_mode"`
+	AllowSimultaneous    types.Bool                       `tfsdk:"allow_simultaneous"`
+	WebhookService       types.String                     `tfsdk:"webhook_service"`
+	WebhookCredential    types.Int64                      `tfsdk:"webhook_credential"`
+	Credentials          types.List                       `tfsdk:"credentials"`
+	Labels               types.Set                        `tfsdk:"labels"`
+
+	AskCredentialOnLaunch           types.Bool `tfsdk:"ask_credential_on_launch"`
+	AskDiffModeOnLaunch             types.Bool `tfsdk:"ask_diff_mode_on_launch"`
+	AskExecutionEnvironmentOnLaunch types.Bool `tfsdk:"ask_execution_environment_on_launch"`
+	AskVariablesOnLaunch            types.Bool `tfsdk:"ask_variables_on_launch"`
+	AskForksOnLaunch                types.Bool `tfsdk:"ask_forks_on_launch"`
+	AskInstanceGroupsOnLaunch       types.Bool `tfsdk:"ask_instance_groups_on_launch"`
+	AskInventoryOnLaunch            types.Bool `tfsdk:"ask_inventory_on_launch"`
+	AskJobSliceCountOnLaunch        types.Bool `tfsdk:"ask_job_slice_count_on_launch"`
+	AskTagsOnLaunch                 types.Bool `tfsdk:"ask_tags_on_launch"`
+	AskJobTypeOnLaunch              types.Bool `tfsdk:"ask_job_type_on_launch"`
+	AskLabelsOnLaunch               types.Bool `tfsdk:"ask_labels_on_launch"`
+	AskLimitOnLaunch                types.Bool `tfsdk:"ask_limit_on_launch"`
+	AskScmBranchOnLaunch            types.Bool `tfsdk:"ask_scm_branch_on_launch"`
+	AskSkipTagsOnLaunch             types.Bool `tfsdk:"ask_skip_tags_on_launch"`
+	AskTimeoutOnLaunch              types.Bool `tfsdk:"ask_timeout_on_launch"`
+	AskVerbosityOnLaunch            types.Bool `tfsdk:"ask_verbosity_on_launch"`
+}
+
+// JobTemplateResource is the resource implementation.
+type JobTemplateResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &JobTemplateResource{}
+	_ resource.ResourceWithConfigure = &JobTemplateResource{}
+)
+
+// NewJobTemplateResource is a helper function to simplify the provider implementation.
+func NewJobTemplateResource() resource.Resource {
+	return &JobTemplateResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *JobTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_template"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *JobTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the job template resource.
+func (r *JobTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Description: "Job template id",
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Description: "URL of the job template",
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the job template",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: "Description for the job template",
+		},
+		"job_type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("run"),
+			Validators:  []validator.String{stringvalidator.OneOf("run", "check")},
+			Description: "Type of the jobs launched from the job template, either run or check. Defaults to run.",
+		},
+		"project": schema.Int64Attribute{
+			Required:    true,
+			Description: "Identifier for the project containing the playbook.",
+		},
+		"playbook": schema.StringAttribute{
+			Required:    true,
+			Description: "Path of the playbook to run, relative to the root of the project.",
+		},
+		"scm_branch": schema.StringAttribute{
+			Optional:    true,
+			Description: "Branch to use in job runs. Project default is used if not provided.",
+		},
+		"inventory": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Identifier for the inventory the jobs run against.",
+		},
+		"execution_environment": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Identifier for the execution environment used to run the jobs.",
+		},
+		"forks": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			Description: "Number of parallel processes used to run the playbook. Defaults to 0, which uses the Ansible default.",
+		},
+		"limit": schema.StringAttribute{
+			Optional:    true,
+			Description: "Host pattern to further constrain the list of hosts managed or affected by the playbook.",
+		},
+		"verbosity": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Validators:  []validator.Int64{int64validator.Between(0, maxJobVerbosity)},
+			Description: "Level of output Ansible produces as the playbook runs, from 0 (normal) to 5 (WinRM debug). Defaults to 0.",
+		},
+		"timeout": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			Description: "Number of seconds to run before the job is canceled. Defaults to 0, which means no timeout.",
+		},
+		"job_tags": schema.StringAttribute{
+			Optional:    true,
+			Description: "Comma separated list of tags to run from the playbook.",
+		},
+		"skip_tags": schema.StringAttribute{
+			Optional:    true,
+			Description: "Comma separated list of tags to skip from the playbook.",
+		},
+		"extra_vars": schema.StringAttribute{
+			Optional:    true,
+			CustomType:  customtypes.AAPCustomStringType{},
+			Description: "Extra variables passed to the playbook. Must be provided as either a JSON or YAML string.",
+		},
+		"become_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Run the playbook with administrator privileges. Defaults to false.",
+		},
+		"diff_mode": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Show the changes made by Ansible tasks, where supported. Defaults to false.",
+		},
+		"allow_simultaneous": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Allow jobs from the job template to run concurrently. Defaults to false.",
+		},
+		"webhook_service": schema.StringAttribute{
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.OneOf("github", "gitlab", "bitbucket_dc")},
+			Description: "Service that can launch jobs from the job template using webhooks, one of github, gitlab or bitbucket_dc.",
+		},
+		"webhook_credential": schema.Int64Attribute{
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("webhook_service"))},
+			Description: "Identifier for the credential used to send status updates back to the webhook service.",
+		},
+		"credentials": schema.ListAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			Description: "Ordered list of credential ids used by the jobs. If not provided, the credentials of the job template are left untouched.",
+		},
+		"labels": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+			Description: "Set of label ids of the job template. If not provided, the labels of the job template are left untouched. " +
+				"AAP deletes the labels no longer used by any resource.",
+		},
+	}
+
+	for field, flag := range askOnLaunchFlags {
+		attributes[flag] = schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: fmt.Sprintf("Prompt for %s when launching a job from the job template. Defaults to false.", strings.ReplaceAll(field, "_", " ")),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Create creates the job template resource and sets the Terraform state on success.
+func (r *JobTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into job template resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from job template data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new job template in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/job_templates/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new job template data into job template resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the created job template into Terraform state, so that it is tracked even if handling its credentials
+	// or labels fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleCredentials(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest job template data.
+func (r *JobTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into job template resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest job template data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest job template data into job template resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadCredentials(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the job template resource and sets the updated Terraform state on success.
+func (r *JobTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into job template resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from job template data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update job template in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated job template data into job template resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleCredentials(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the job template resource.
+func (r *JobTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data JobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into job template resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete job template from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the job template resource data
+func (r *JobTemplateResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert job template resource data to API data model
+	jobTemplate := JobTemplateAPIModel{
+		Name:                 r.Name.ValueString(),
+		Description:          r.Description.ValueString(),
+		JobType:              r.JobType.ValueString(),
+		Project:              r.Project.ValueInt64(),
+		Playbook:             r.Playbook.ValueString(),
+		ScmBranch:            r.ScmBranch.ValueString(),
+		Inventory:            r.Inventory.ValueInt64Pointer(),
+		ExecutionEnvironment: r.ExecutionEnvironment.ValueInt64Pointer(),
+		Forks:                r.Forks.ValueInt64(),
+		Limit:                r.Limit.ValueString(),
+		Verbosity:            r.Verbosity.ValueInt64(),
+		Timeout:              r.Timeout.ValueInt64(),
+		JobTags:              r.JobTags.ValueString(),
+		SkipTags:             r.SkipTags.ValueString(),
+		ExtraVars:            r.ExtraVars.ValueString(),
+		BecomeEnabled:        r.BecomeEnabled.ValueBool(),
+		DiffMode:             r.DiffMode.ValueBool(),
+		AllowSimultaneous:    r.AllowSimultaneous.ValueBool(),
+		WebhookService:       r.WebhookService.ValueString(),
+		WebhookCredential:    r.WebhookCredential.ValueInt64Pointer(),
+
+		AskCredentialOnLaunch:           r.AskCredentialOnLaunch.ValueBool(),
+		AskDiffModeOnLaunch:             r.AskDiffModeOnLaunch.ValueBool(),
+		AskExecutionEnvironmentOnLaunch: r.AskExecutionEnvironmentOnLaunch.ValueBool(),
+		AskVariablesOnLaunch:            r.AskVariablesOnLaunch.ValueBool(),
+		AskForksOnLaunch:                r.AskForksOnLaunch.ValueBool(),
+		AskInstanceGroupsOnLaunch:       r.AskInstanceGroupsOnLaunch.ValueBool(),
+		AskInventoryOnLaunch:            r.AskInventoryOnLaunch.ValueBool(),
+		AskJobSliceCountOnLaunch:        r.AskJobSliceCountOnLaunch.ValueBool(),
+		AskTagsOnLaunch:                 r.AskTagsOnLaunch.ValueBool(),
+		AskJobTypeOnLaunch:              r.AskJobTypeOnLaunch.ValueBool(),
+		AskLabelsOnLaunch:               r.AskLabelsOnLaunch.ValueBool(),
+		AskLimitOnLaunch:                r.AskLimitOnLaunch.ValueBool(),
+		AskScmBranchOnLaunch:            r.AskScmBranchOnLaunch.ValueBool(),
+		AskSkipTagsOnLaunch:             r.AskSkipTagsOnLaunch.ValueBool(),
+		AskTimeoutOnLaunch:              r.AskTimeoutOnLaunch.ValueBool(),
+		AskVerbosityOnLaunch:            r.AskVerbosityOnLaunch.ValueBool(),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(jobTemplate)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for job template resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHttpResponse updates the job template resource data from an AAP API response
+func (r *JobTemplateResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiJobTemplate JobTemplateAPIModel
+	err := json.Unmarshal(body, &apiJobTemplate)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the job template resource schema and update attribute values
+	r.Id = types.Int64Value(apiJobTemplate.Id)
+	r.Url = types.StringValue(apiJobTemplate.Url)
+	r.Name = types.StringValue(apiJobTemplate.Name)
+	r.Description = ParseStringValue(apiJobTemplate.Description)
+	r.JobType = types.StringValue(apiJobTemplate.JobType)
+	r.Project = types.Int64Value(apiJobTemplate.Project)
+	r.Playbook = types.StringValue(apiJobTemplate.Playbook)
+	r.ScmBranch = ParseStringValue(apiJobTemplate.ScmBranch)
+	r.Inventory = types.Int64PointerValue(apiJobTemplate.Inventory)
+	r.ExecutionEnvironment = types.Int64PointerValue(apiJobTemplate.ExecutionEnvironment)
+	r.Forks = types.Int64Value(apiJobTemplate.Forks)
+	r.Limit = ParseStringValue(apiJobTemplate.Limit)
+	r.Verbosity = types.Int64Value(apiJobTemplate.Verbosity)
+	r.Timeout = types.Int64Value(apiJobTemplate.Timeout)
+	r.JobTags = ParseStringValue(apiJobTemplate.JobTags)
+	r.SkipTags = ParseStringValue(apiJobTemplate.SkipTags)
+	r.ExtraVars = ParseAAPCustomStringValue(apiJobTemplate.ExtraVars)
+	r.BecomeEnabled = types.BoolValue(apiJobTemplate.BecomeEnabled)
+	r.DiffMode = types.BoolValue(apiJobTemplate.DiffMode)
+	r.AllowSimultaneous = types.BoolValue(apiJobTemplate.AllowSimultaneous)
+	r.WebhookService = ParseStringValue(apiJobTemplate.WebhookService)
+	r.WebhookCredential = types.Int64PointerValue(apiJobTemplate.WebhookCredential)
+
+	r.AskCredentialOnLaunch = types.BoolValue(apiJobTemplate.AskCredentialOnLaunch)
+	r.AskDiffModeOnLaunch = types.BoolValue(apiJobTemplate.AskDiffModeOnLaunch)
+	r.AskExecutionEnvironmentOnLaunch = types.BoolValue(apiJobTemplate.AskExecutionEnvironmentOnLaunch)
+	r.AskVariablesOnLaunch = types.BoolValue(apiJobTemplate.AskVariablesOnLaunch)
+	r.AskForksOnLaunch = types.BoolValue(apiJobTemplate.AskForksOnLaunch)
+	r.AskInstanceGroupsOnLaunch = types.BoolValue(apiJobTemplate.AskInstanceGroupsOnLaunch)
+	r.AskInventoryOnLaunch = types.BoolValue(apiJobTemplate.AskInventoryOnLaunch)
+	r.AskJobSliceCountOnLaunch = types.BoolValue(apiJobTemplate.AskJobSliceCountOnLaunch)
+	r.AskTagsOnLaunch = types.BoolValue(apiJobTemplate.AskTagsOnLaunch)
+	r.AskJobTypeOnLaunch = types.BoolValue(apiJobTemplate.AskJobTypeOnLaunch)
+	r.AskLabelsOnLaunch = types.BoolValue(apiJobTemplate.AskLabelsOnLaunch)
+	r.AskLimitOnLaunch = types.BoolValue(apiJobTemplate.AskLimitOnLaunch)
+	r.AskScmBranchOnLaunch = types.BoolValue(apiJobTemplate.AskScmBranchOnLaunch)
+	r.AskSkipTagsOnLaunch = types.BoolValue(apiJobTemplate.AskSkipTagsOnLaunch)
+	r.AskTimeoutOnLaunch = types.BoolValue(apiJobTemplate.AskTimeoutOnLaunch)
+	r.AskVerbosityOnLaunch = types.BoolValue(apiJobTemplate.AskVerbosityOnLaunch)
+
+	return diags
+}
+
+// HandleCredentials associates the credentials from the plan with the job template, in order,
+// and saves the resulting credentials into the job template resource model.
+func (r *JobTemplateResource) HandleCredentials(ctx context.Context, data *JobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if IsValueProvided(data.Credentials) {
+		credentials := make([]int64, 0, len(data.Credentials.Elements()))
+		diags.Append(data.Credentials.ElementsAs(ctx, &credentials, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		url, diagsURL := getURL(data.Url.ValueString(), "credentials")
+		diags.Append(diagsURL...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(reconcileOrderedAssociation(r.client, url, credentials)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadCredentials(ctx, data)...)
+	return diags
+}
+
+// ReadCredentials saves the credentials associated with the job template into the job template resource model.
+func (r *JobTemplateResource) ReadCredentials(ctx context.Context, data *JobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(data.Url.ValueString(), "credentials")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	credentials, diagsRead := readAssociatedIDs(r.client, url)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	convertedCredentials, diagsConvert := types.ListValueFrom(ctx, types.Int64Type, credentials)
+	diags.Append(diagsConvert...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Credentials = convertedCredentials
+
+	return diags
+}
+
+// HandleLabels associates the labels from the plan with the job template, and saves the resulting labels into the job
+// template resource model.
+func (r *JobTemplateResource) HandleLabels(ctx context.Context, data *JobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if IsValueProvided(data.Labels) {
+		labels := make([]int64, 0, len(data.Labels.Elements()))
+		diags.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		url, diagsURL := getURL(data.Url.ValueString(), "labels")
+		diags.Append(diagsURL...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(reconcileAssociation(r.client, url, labels)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadLabels(ctx, data)...)
+	return diags
+}
+
+// ReadLabels saves the labels associated with the job template into the job template resource model.
+func (r *JobTemplateResource) ReadLabels(ctx context.Context, data *JobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(data.Url.ValueString(), "labels")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	labels, diagsRead := readAssociatedIDs(r.client, url)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	convertedLabels, diagsConvert := types.SetValueFrom(ctx, types.Int64Type, labels)
+	diags.Append(diagsConvert...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Labels = convertedLabels
+
+	return diags
+}
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestJobTemplateResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the JobTemplateResource and call its Schema method
+	NewJobTemplateResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+
+	// Every prompt on launch flag reported by the job resource must be configurable
+	for _, flag := range askOnLaunchFlags {
+		if _, ok := schemaResponse.Schema.Attributes[flag]; !ok {
+			t.Errorf("Expected attribute %s in job template schema", flag)
+		}
+	}
+}
+
+// newJobTemplateResourceModel returns a job template resource model holding the schema defaults.
+func newJobTemplateResourceModel() JobTemplateResourceModel {
+	return JobTemplateResourceModel{
+		Name:                            types.StringValue("test job template"),
+		Description:                     types.StringNull(),
+		JobType:                         types.StringValue("run"),
+		Project:                         types.Int64Value(6),
+		Playbook:                        types.StringValue("hello_world.yml"),
+		ScmBranch:                       types.StringNull(),
+		Inventory:                       types.Int64Null(),
+		ExecutionEnvironment:            types.Int64Null(),
+		Forks:                           types.Int64Value(0),
+		Limit:                           types.StringNull(),
+		Verbosity:                       types.Int64Value(0),
+		Timeout:                         types.Int64Value(0),
+		JobTags:                         types.StringNull(),
+		SkipTags:                        types.StringNull(),
+		ExtraVars:                       customtypes.NewAAPCustomStringNull(),
+		BecomeEnabled:                   types.BoolValue(false),
+		DiffMode:                        types.BoolValue(false),
+		AllowSimultaneous:               types.BoolValue(false),
+		WebhookService:                  types.StringNull(),
+		WebhookCredential:               types.Int64Null(),
+		AskCredentialOnLaunch:           types.BoolValue(false),
+		AskDiffModeOnLaunch:             types.BoolValue(false),
+		AskExecutionEnvironmentOnLaunch: types.BoolValue(false),
+		AskVariablesOnLaunch:            types.BoolValue(false),
+		AskForksOnLaunch:                types.BoolValue(false),
+		AskInstanceGroupsOnLaunch:       types.BoolValue(false),
+		AskInventoryOnLaunch:            types.BoolValue(false),
+		AskJobSliceCountOnLaunch:        types.BoolValue(false),
+		AskTagsOnLaunch:                 types.BoolValue(false),
+		AskJobTypeOnLaunch:              types.BoolValue(false),
+		AskLabelsOnLaunch:               types.BoolValue(false),
+		AskLimitOnLaunch:                types.BoolValue(false),
+		AskScmBranchOnLaunch:            types.BoolValue(false),
+		AskSkipTagsOnLaunch:             types.BoolValue(false),
+		AskTimeoutOnLaunch:              types.BoolValue(false),
+		AskVerbosityOnLaunch:            types.BoolValue(false),
+	}
+}
+
+const jobTemplateDefaultsJSON = `"description":"","job_type":"run","project":6,"playbook":"hello_world.yml","scm_branch":"",` +
+	`"forks":0,"limit":"","verbosity":0,"timeout":0,"job_tags":"","skip_tags":"","extra_vars":"",` +
+	`"become_enabled":false,"diff_mode":false,"allow_simultaneous":false,"webhook_service":"","webhook_credential":null,` +
+	`"ask_credential_on_launch":false,"ask_diff_mode_on_launch":false,"ask_execution_environment_on_launch":false,` +
+	`"ask_variables_on_launch":false,"ask_forks_on_launch":false,"ask_instance_groups_on_launch":false,` +
+	`"ask_inventory_on_launch":false,"ask_job_slice_count_on_launch":false,"ask_tags_on_launch":false,` +
+	`"ask_job_type_on_launch":false,"ask_labels_on_launch":false,"ask_limit_on_launch":false,` +
+	`"ask_scm_branch_on_launch":false,"ask_skip_tags_on_launch":false,"ask_timeout_on_launch":false,` +
+	`"ask_verbosity_on_launch":false`
+
+func TestJobTemplateResourceCreateRequestBody(t *testing.T) {
+	withValues := newJobTemplateResourceModel()
+	withValues.Description = types.StringValue("A test job template")
+	withValues.JobType = types.StringValue("check")
+	withValues.ScmBranch = types.StringValue("devel")
+	withValues.Inventory = types.Int64Value(1)
+	withValues.ExecutionEnvironment = types.Int64Value(2)
+	withValues.Forks = types.Int64Value(10)
+	withValues.Limit = types.StringValue("webservers")
+	withValues.Verbosity = types.Int64Value(3)
+	withValues.Timeout = types.Int64Value(600)
+	withValues.JobTags = types.StringValue("install,configure")
+	withValues.SkipTags = types.StringValue("debug")
+	withValues.ExtraVars = customtypes.NewAAPCustomStringValue("foo: bar")
+	withValues.BecomeEnabled = types.BoolValue(true)
+	withValues.WebhookService = types.StringValue("github")
+	withValues.WebhookCredential = types.Int64Value(4)
+	withValues.AskInventoryOnLaunch = types.BoolValue(true)
+	withValues.AskVariablesOnLaunch = types.BoolValue(true)
+
+	var testTable = []struct {
+		name     string
+		input    JobTemplateResourceModel
+		expected []byte
+	}{
+		{
+			name:  "defaults",
+			input: newJobTemplateResourceModel(),
+			expected: []byte(`{"name":"test job template","inventory":null,"execution_environment":null,` +
+				jobTemplateDefaultsJSON + `}`),
+		},
+		{
+			name:  "provided values",
+			input: withValues,
+			expected: []byte(`{"name":"test job template","description":"A test job template","job_type":"check",` +
+				`"project":6,"playbook":"hello_world.yml","scm_branch":"devel","inventory":1,"execution_environment":2,` +
+				`"forks":10,"limit":"webservers","verbosity":3,"timeout":600,"job_tags":"install,configure","skip_tags":"debug",` +
+				`"extra_vars":"foo: bar","become_enabled":true,"diff_mode":false,"allow_simultaneous":false,` +
+				`"webhook_service":"github","webhook_credential":4,` +
+				`"ask_credential_on_launch":false,"ask_diff_mode_on_launch":false,"ask_execution_environment_on_launch":false,` +
+				`"ask_variables_on_launch":true,"ask_forks_on_launch":false,"ask_instance_groups_on_launch":false,` +
+				`"ask_inventory_on_launch":true,"ask_job_slice_count_on_launch":false,"ask_tags_on_launch":false,` +
+				`"ask_job_type_on_launch":false,"ask_labels_on_launch":false,"ask_limit_on_launch":false,` +
+				`"ask_scm_branch_on_launch":false,"ask_skip_tags_on_launch":false,"ask_timeout_on_launch":false,` +
+				`"ask_verbosity_on_launch":false}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestJobTemplateResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	defaults := newJobTemplateResourceModel()
+	defaults.Id = types.Int64Value(7)
+	defaults.Url = types.StringValue("/api/v2/job_templates/7/")
+
+	withValues := newJobTemplateResourceModel()
+	withValues.Id = types.Int64Value(7)
+	withValues.Url = types.StringValue("/api/v2/job_templates/7/")
+	withValues.Inventory = types.Int64Value(1)
+	withValues.Limit = types.StringValue("webservers")
+	withValues.ExtraVars = customtypes.NewAAPCustomStringValue("{\"foo\": \"bar\"}")
+	withValues.AskLimitOnLaunch = types.BoolValue(true)
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected JobTemplateResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: JobTemplateResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "defaults",
+			input: []byte(`{"id":7,"url":"/api/v2/job_templates/7/","name":"test job template","inventory":null,` +
+				`"execution_environment":null,` + jobTemplateDefaultsJSON + `}`),
+			expected: defaults,
+			errors:   diag.Diagnostics{},
+		},
+		{
+			name: "provided values",
+			input: []byte(`{"id":7,"url":"/api/v2/job_templates/7/","name":"test job template","inventory":1,` +
+				`"execution_environment":null,` + strings.NewReplacer(
+				`"limit":""`, `"limit":"webservers"`,
+				`"extra_vars":""`, `"extra_vars":"{\"foo\": \"bar\"}"`,
+				`"ask_limit_on_launch":false`, `"ask_limit_on_launch":true`,
+			).Replace(jobTemplateDefaultsJSON) + `}`),
+			expected: withValues,
+			errors:   diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := JobTemplateResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func testAccJobTemplateResourcePreCheck(t *testing.T) {
+	// Ensure provider requirements
+	testAccPreCheck(t)
+
+	requiredAAPJobTemplateEnvVars := []string{
+		"AAP_TEST_PROJECT_ID",
+		"AAP_TEST_PLAYBOOK",
+		"AAP_TEST_INVENTORY_ID",
+	}
+
+	for _, key := range requiredAAPJobTemplateEnvVars {
+		if v := os.Getenv(key); v == "" {
+			t.Fatalf("'%s' environment variable must be set when running acceptance tests for job template resource", key)
+		}
+	}
+}
+
+func TestAccJobTemplateResource(t *testing.T) {
+	var jobTemplate JobTemplateAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectID := os.Getenv("AAP_TEST_PROJECT_ID")
+	playbook := os.Getenv("AAP_TEST_PLAYBOOK")
+	inventoryID := os.Getenv("AAP_TEST_INVENTORY_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobTemplateResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJobTemplateResourceMinimal(randomName, projectID, playbook),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateResourceExists("aap_job_template.test", &jobTemplate),
+					resource.TestCheckResourceAttr("aap_job_template.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_job_template.test", "project", projectID),
+					resource.TestCheckResourceAttr("aap_job_template.test", "playbook", playbook),
+					resource.TestCheckResourceAttr("aap_job_template.test", "job_type", "run"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "ask_inventory_on_launch", "true"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "credentials.#", "0"),
+					resource.TestCheckResourceAttrSet("aap_job_template.test", "id"),
+					resource.TestCheckResourceAttrSet("aap_job_template.test", "url"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccJobTemplateResourceComplete(randomName, projectID, playbook, inventoryID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateResourceExists("aap_job_template.test", &jobTemplate),
+					resource.TestCheckResourceAttr("aap_job_template.test", "job_type", "check"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "inventory", inventoryID),
+					resource.TestCheckResourceAttr("aap_job_template.test", "forks", "5"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "verbosity", "2"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "limit", "localhost"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "extra_vars", "foo: bar"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "ask_inventory_on_launch", "false"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "ask_variables_on_launch", "true"),
+					resource.TestCheckResourceAttr("aap_job_template.test", "webhook_service", "github"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckJobTemplateResourceDestroy,
+	})
+}
+
+// testAccJobTemplateResourceMinimal returns a configuration for an AAP Job Template prompting for the inventory.
+func testAccJobTemplateResourceMinimal(name string, projectID string, playbook string) string {
+	return fmt.Sprintf(`
+resource "aap_job_template" "test" {
+  name                    = "%s"
+  project                 = %s
+  playbook                = "%s"
+  ask_inventory_on_launch = true
+}`, name, projectID, playbook)
+}
+
+// testAccJobTemplateResourceComplete returns a configuration for an AAP Job Template with most options.
+func testAccJobTemplateResourceComplete(name string, projectID string, playbook string, inventoryID string) string {
+	return fmt.Sprintf(`
+resource "aap_job_template" "test" {
+  name                    = "%s"
+  description             = "A test job template"
+  job_type                = "check"
+  project                 = %s
+  playbook                = "%s"
+  inventory               = %s
+  forks                   = 5
+  verbosity               = 2
+  limit                   = "localhost"
+  extra_vars              = "foo: bar"
+  become_enabled          = true
+  webhook_service         = "github"
+  ask_variables_on_launch = true
+  credentials             = []
+}`, name, projectID, playbook, inventoryID)
+}
+
+// testAccCheckJobTemplateResourceExists queries the AAP API and retrieves the matching job template.
+func testAccCheckJobTemplateResourceExists(name string, jobTemplate *JobTemplateAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		jobTemplateResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("job template (%s) not found in state", name)
+		}
+
+		jobTemplateResponseBody, err := testGetResource(jobTemplateResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(jobTemplateResponseBody, &jobTemplate)
+		if err != nil {
+			return err
+		}
+
+		if jobTemplate.Id == 0 {
+			return fmt.Errorf("job template (%s) not found in AAP", jobTemplateResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckJobTemplateResourceDestroy verifies the job template has been destroyed.
+func testAccCheckJobTemplateResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_job_template" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("job template (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Label AAP API model
+type LabelAPIModel struct {
+	Id           int64  `json:"id,omitempty"`
+	Url          string `json:"url,omitempty"`
+	Name         string `json:"name"`
+	Organization int64  `json:"organization"`
+}
+
+// LabelResourceModel maps the label resource schema to a Go struct.
+type LabelResourceModel struct {
+	Id           types.Int64  `tfsdk:"id"`
+	Url          types.String `tfsdk:"url"`
+	Name         types.String `tfsdk:"name"`
+	Organization types.Int64  `tfsdk:"organization"`
+}
+
+// LabelResource is the resource implementation.
+type LabelResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &LabelResource{}
+	_ resource.ResourceWithConfigure   = &LabelResource{}
+	_ resource.ResourceWithImportState = &LabelResource{}
+)
+
+// NewLabelResource is a helper function to simplify the provider implementation.
+func NewLabelResource() resource.Resource {
+	return &LabelResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *LabelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *LabelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the label resource.
+func (r *LabelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Label id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the label",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the label",
+			},
+			"organization": schema.Int64Attribute{
+				Required:    true,
+				Description: "Identifier for the organization the label belongs to.",
+			},
+		},
+	}
+}
+
+// Create creates the label resource and sets the Terraform state on success.
+func (r *LabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LabelResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into label resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from label data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new label in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/labels/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new label data into label resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest label data.
+func (r *LabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LabelResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into label resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest label data from AAP, labels no longer used by any resource are deleted by AAP
+	getResponse, readResponseBody, err := r.client.doRequest(http.MethodGet, data.Url.ValueString(), nil)
+	if getResponse != nil && getResponse.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(ValidateResponse(getResponse, readResponseBody, err, []int{http.StatusOK})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest label data into label resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the label resource and sets the updated Terraform state on success.
+func (r *LabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LabelResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into label resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from label data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update label in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated label data into label resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the label resource from the Terraform state. AAP does not allow deleting labels, it deletes them
+// once they are no longer used by any resource.
+func (r *LabelResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports an existing label from its organization name and its name, separated by a slash.
+func (r *LabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data LabelResourceModel
+
+	organization, name, diags := parseOrganizationImportID(req.ID, "label")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get label data from AAP
+	readResponseBody, diags := getResourceByOrganizationAndName(r.client, "/api/v2/labels/", organization, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save label data into label resource model
+	resp.Diagnostics.Append(data.ParseHttpResponse(readResponseBody)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save imported data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the label resource data
+func (r *LabelResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert label resource data to API data model
+	label := LabelAPIModel{
+		Name:         r.Name.ValueString(),
+		Organization: r.Organization.ValueInt64(),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(label)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for label resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHttpResponse updates the label resource data from an AAP API response
+func (r *LabelResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiLabel LabelAPIModel
+	err := json.Unmarshal(body, &apiLabel)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the label resource schema and update attribute values
+	r.Id = types.Int64Value(apiLabel.Id)
+	r.Url = types.StringValue(apiLabel.Url)
+	r.Name = types.StringValue(apiLabel.Name)
+	r.Organization = types.Int64Value(apiLabel.Organization)
+
+	return diags
+}
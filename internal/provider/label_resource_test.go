@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestLabelResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the LabelResource and call its Schema method
+	NewLabelResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestLabelResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    LabelResourceModel
+		expected []byte
+	}{
+		{
+			name: "provided values",
+			input: LabelResourceModel{
+				Name:         types.StringValue("production"),
+				Organization: types.Int64Value(2),
+			},
+			expected: []byte(`{"name":"production","organization":2}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLabelResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected LabelResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: LabelResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "provided values",
+			input: []byte(`{"id":3,"url":"/api/v2/labels/3/","name":"production","organization":1}`),
+			expected: LabelResourceModel{
+				Id:           types.Int64Value(3),
+				Url:          types.StringValue("/api/v2/labels/3/"),
+				Name:         types.StringValue("production"),
+				Organization: types.Int64Value(1),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := LabelResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccLabelResource(t *testing.T) {
+	var label LabelAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	updatedName := randomName + "-updated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLabelResource(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLabelResourceExists("aap_label.test", &label),
+					resource.TestCheckResourceAttr("aap_label.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_label.test", "organization", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccLabelResource(updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLabelResourceExists("aap_label.test", &label),
+					resource.TestCheckResourceAttr("aap_label.test", "name", updatedName),
+				),
+			},
+			// Import by organization name and name testing
+			{
+				ResourceName:      "aap_label.test",
+				ImportState:       true,
+				ImportStateId:     "Default/" + updatedName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccLabelResource returns a configuration for an AAP Label with the provided name.
+func testAccLabelResource(name string) string {
+	return fmt.Sprintf(`
+resource "aap_label" "test" {
+  name         = "%s"
+  organization = 1
+}`, name)
+}
+
+// testAccCheckLabelResourceExists queries the AAP API and retrieves the matching label.
+func testAccCheckLabelResourceExists(name string, label *LabelAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		labelResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("label (%s) not found in state", name)
+		}
+
+		labelResponseBody, err := testGetResource(labelResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(labelResponseBody, &label)
+		if err != nil {
+			return err
+		}
+
+		if label.Id == 0 {
+			return fmt.Errorf("label (%s) not found in AAP", labelResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationTemplateAttachmentTargets are the attributes of the resources notification templates can be attached to.
+var notificationTemplateAttachmentTargets = []string{"job_template", "workflow_job_template", "project", "organization"}
+
+// NotificationTemplateAttachmentResourceModel maps the notification template attachment resource schema to a Go struct.
+type NotificationTemplateAttachmentResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	NotificationTemplate types.Int64  `tfsdk:"notification_template"`
+	JobTemplate          types.Int64  `tfsdk:"job_template"`
+	WorkflowJobTemplate  types.Int64  `tfsdk:"workflow_job_template"`
+	Project              types.Int64  `tfsdk:"project"`
+	Organization         types.Int64  `tfsdk:"organization"`
+	Event                types.String `tfsdk:"event"`
+}
+
+// NotificationTemplateAttachmentResource is the resource implementation.
+type NotificationTemplateAttachmentResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &NotificationTemplateAttachmentResource{}
+	_ resource.ResourceWithConfigure = &NotificationTemplateAttachmentResource{}
+)
+
+// NewNotificationTemplateAttachmentResource is a helper function to simplify the provider implementation.
+func NewNotificationTemplateAttachmentResource() resource.Resource {
+	return &NotificationTemplateAttachmentResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *NotificationTemplateAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_template_attachment"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NotificationTemplateAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the notification template attachment resource.
+func (r *NotificationTemplateAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	targets := make([]path.Expression, 0, len(notificationTemplateAttachmentTargets))
+	for _, target := range notificationTemplateAttachmentTargets {
+		targets = append(targets, path.MatchRoot(target))
+	}
+	targetAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional:   true,
+			Validators: []validator.Int64{int64validator.ExactlyOneOf(targets...)},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Description: description + " Exactly one of job_template, workflow_job_template, project or organization must be provided.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier of the attachment, made of the URL the notification template is attached at and " +
+					"the notification template id.",
+			},
+			"notification_template": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the attached notification template.",
+			},
+			"job_template":          targetAttribute("Identifier for the job template the notification template is attached to."),
+			"workflow_job_template": targetAttribute("Identifier for the workflow job template the notification template is attached to."),
+			"project":               targetAttribute("Identifier for the project the notification template is attached to."),
+			"organization":          targetAttribute("Identifier for the organization the notification template is attached to."),
+			"event": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.OneOf("started", "success", "error")},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Job event sending the notifications, one of started, success or error.",
+			},
+		},
+	}
+}
+
+// Create attaches the notification template and sets the Terraform state on success.
+func (r *NotificationTemplateAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationTemplateAttachmentResourceModel
+
+	// Read Terraform plan data into notification template attachment resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attach notification template in AAP
+	attachmentURL := data.AttachmentURL()
+	resp.Diagnostics.Append(associateIDs(r.client, attachmentURL, []int64{data.NotificationTemplate.ValueInt64()}, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s%d", attachmentURL, data.NotificationTemplate.ValueInt64()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state, removing the attachment when the notification template is no longer attached.
+func (r *NotificationTemplateAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationTemplateAttachmentResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into notification template attachment resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get notification templates attached in AAP
+	attachedIDs, diags := readAssociatedIDs(r.client, data.AttachmentURL())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !slices.Contains(attachedIDs, data.NotificationTemplate.ValueInt64()) {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+// Update is never called, since changing any attribute replaces the attachment.
+func (r *NotificationTemplateAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NotificationTemplateAttachmentResourceModel
+
+	// Read Terraform plan data into notification template attachment resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete detaches the notification template.
+func (r *NotificationTemplateAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationTemplateAttachmentResourceModel
+
+	// Read current Terraform state data into notification template attachment resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Detach notification template in AAP
+	resp.Diagnostics.Append(associateIDs(r.client, data.AttachmentURL(), []int64{data.NotificationTemplate.ValueInt64()}, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// AttachmentURL returns the URL listing the notification templates attached to the target resource for the event.
+func (r *NotificationTemplateAttachmentResourceModel) AttachmentURL() string {
+	var collection string
+	var id int64
+	switch {
+	case !r.JobTemplate.IsNull():
+		collection, id = "job_templates", r.JobTemplate.ValueInt64()
+	case !r.WorkflowJobTemplate.IsNull():
+		collection, id = "workflow_job_templates", r.WorkflowJobTemplate.ValueInt64()
+	case !r.Project.IsNull():
+		collection, id = "projects", r.Project.ValueInt64()
+	default:
+		collection, id = "organizations", r.Organization.ValueInt64()
+	}
+
+	return fmt.Sprintf("/api/v2/%s/%d/notification_templates_%s/", collection, id, r.Event.ValueString())
+}
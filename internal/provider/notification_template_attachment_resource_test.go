@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestNotificationTemplateAttachmentResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the NotificationTemplateAttachmentResource and call its Schema method
+	NewNotificationTemplateAttachmentResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestNotificationTemplateAttachmentResourceAttachmentURL(t *testing.T) {
+	t.Parallel()
+
+	attachment := func(jobTemplate, workflowJobTemplate, project, organization types.Int64, event string) NotificationTemplateAttachmentResourceModel {
+		return NotificationTemplateAttachmentResourceModel{
+			NotificationTemplate: types.Int64Value(3),
+			JobTemplate:          jobTemplate,
+			WorkflowJobTemplate:  workflowJobTemplate,
+			Project:              project,
+			Organization:         organization,
+			Event:                types.StringValue(event),
+		}
+	}
+	null := types.Int64Null()
+
+	var testTable = []struct {
+		name     string
+		input    NotificationTemplateAttachmentResourceModel
+		expected string
+	}{
+		{
+			name:     "job template",
+			input:    attachment(types.Int64Value(7), null, null, null, "error"),
+			expected: "/api/v2/job_templates/7/notification_templates_error/",
+		},
+		{
+			name:     "workflow job template",
+			input:    attachment(null, types.Int64Value(8), null, null, "success"),
+			expected: "/api/v2/workflow_job_templates/8/notification_templates_success/",
+		},
+		{
+			name:     "project",
+			input:    attachment(null, null, types.Int64Value(9), null, "started"),
+			expected: "/api/v2/projects/9/notification_templates_started/",
+		},
+		{
+			name:     "organization",
+			input:    attachment(null, null, null, types.Int64Value(1), "error"),
+			expected: "/api/v2/organizations/1/notification_templates_error/",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.input.AttachmentURL()
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccNotificationTemplateAttachmentResource(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationTemplateAttachmentResource(randomName, "error"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationTemplateAttached("aap_notification_template_attachment.test"),
+					resource.TestCheckResourceAttr("aap_notification_template_attachment.test", "event", "error"),
+				),
+			},
+			// Replace and Read testing
+			{
+				Config: testAccNotificationTemplateAttachmentResource(randomName, "success"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationTemplateAttached("aap_notification_template_attachment.test"),
+					resource.TestCheckResourceAttr("aap_notification_template_attachment.test", "event", "success"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckNotificationTemplateResourceDestroy,
+	})
+}
+
+// testAccNotificationTemplateAttachmentResource returns a configuration attaching a notification template to a new
+// organization for the provided event.
+func testAccNotificationTemplateAttachmentResource(name string, event string) string {
+	return fmt.Sprintf(`
+resource "aap_organization" "test" {
+  name = "%[1]s"
+}
+
+resource "aap_notification_template" "test" {
+  name              = "%[1]s"
+  organization      = aap_organization.test.id
+  notification_type = "webhook"
+  notification_configuration = jsonencode({
+    url                      = "https://example.com"
+    http_method              = "POST"
+    headers                  = {}
+    username                 = ""
+    password                 = ""
+    disable_ssl_verification = false
+  })
+}
+
+resource "aap_notification_template_attachment" "test" {
+  notification_template = aap_notification_template.test.id
+  organization          = aap_organization.test.id
+  event                 = "%[2]s"
+}`, name, event)
+}
+
+// testAccCheckNotificationTemplateAttached queries the AAP API and verifies the notification template is attached.
+func testAccCheckNotificationTemplateAttached(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		attachmentResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("notification template attachment (%s) not found in state", name)
+		}
+
+		notificationTemplateID := attachmentResource.Primary.Attributes["notification_template"]
+		attachmentResponseBody, err := testGetResource(strings.TrimSuffix(attachmentResource.Primary.ID, notificationTemplateID))
+		if err != nil {
+			return err
+		}
+
+		var attached listAPIModel
+		err = json.Unmarshal(attachmentResponseBody, &attached)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range attached.Results {
+			var notificationTemplate NotificationTemplateAPIModel
+			err = json.Unmarshal(result, &notificationTemplate)
+			if err != nil {
+				return err
+			}
+			if strconv.FormatInt(notificationTemplate.Id, 10) == notificationTemplateID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("notification template (%s) not attached in AAP", notificationTemplateID)
+	}
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationTypes are the services AAP can send notifications to.
+var notificationTypes = []string{
+	"email", "grafana", "irc", "mattermost", "pagerduty", "rocketchat", "slack", "twilio", "webhook",
+}
+
+// Notification template AAP API model
+type NotificationTemplateAPIModel struct {
+	Id                        int64           `json:"id,omitempty"`
+	Url                       string          `json:"url,omitempty"`
+	Name                      string          `json:"name"`
+	Description               string          `json:"description"`
+	Organization              int64           `json:"organization"`
+	NotificationType          string          `json:"notification_type"`
+	NotificationConfiguration json.RawMessage `json:"notification_configuration"`
+}
+
+// NotificationTemplateResourceModel maps the notification template resource schema to a Go struct.
+type NotificationTemplateResourceModel struct {
+	Id                        types.Int64                      `tfsdk:"id"`
+	Url                       types.String                     `tfsdk:"url"`
+	Name                      types.String                     `tfsdk:"name"`
+	Description               types.String                     `tfsdk:"description"`
+	Organization              types.Int64                      `tfsdk:"organization"`
+	NotificationType          types.String                     `tfsdk:"notification_type"`
+	NotificationConfiguration customtypes.AAPCustomStringValue `tfsdk:"notification_configuration"`
+}
+
+// NotificationTemplateResource is the resource implementation.
+type NotificationTemplateResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &NotificationTemplateResource{}
+	_ resource.ResourceWithConfigure = &NotificationTemplateResource{}
+)
+
+// NewNotificationTemplateResource is a helper function to simplify the provider implementation.
+func NewNotificationTemplateResource() resource.Resource {
+	return &NotificationTemplateResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *NotificationTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_template"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NotificationTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the notification template resource.
+func (r *NotificationTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Notification template id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the notification template",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the notification template",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the notification template",
+			},
+			"organization": schema.Int64Attribute{
+				Required:    true,
+				Description: "Identifier for the organization the notification template belongs to.",
+			},
+			"notification_type": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.OneOf(notificationTypes...)},
+				Description: "Service the notifications are sent to, one of email, grafana, irc, mattermost, pagerduty, " +
+					"rocketchat, slack, twilio or webhook.",
+			},
+			"notification_configuration": schema.StringAttribute{
+				Required:   true,
+				Sensitive:  true,
+				CustomType: customtypes.AAPCustomStringType{},
+				Description: "Configuration of the notification service, such as the url and token of a slack or webhook " +
+					"notification. Must be provided as either a JSON or YAML string. AAP never returns the value of secret " +
+					"fields, so changes made to them outside of Terraform are not detected.",
+			},
+		},
+	}
+}
+
+// Create creates the notification template resource and sets the Terraform state on success.
+func (r *NotificationTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into notification template resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from notification template data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new notification template in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/notification_templates/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new notification template data into notification template resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest notification template data.
+func (r *NotificationTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into notification template resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest notification template data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest notification template data into notification template resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the notification template resource and sets the updated Terraform state on success.
+func (r *NotificationTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NotificationTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into notification template resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from notification template data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update notification template in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated notification template data into notification template resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the notification template resource.
+func (r *NotificationTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into notification template resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete notification template from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the notification template resource data
+func (r *NotificationTemplateResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, err := decodeJSONOrYAML(r.NotificationConfiguration.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not decode the notification configuration of notification template resource, unexpected error: %s",
+				err.Error()),
+		)
+		return nil, diags
+	}
+	jsonConfiguration, _ := json.Marshal(configuration)
+
+	// Convert notification template resource data to API data model
+	notificationTemplate := NotificationTemplateAPIModel{
+		Name:                      r.Name.ValueString(),
+		Description:               r.Description.ValueString(),
+		Organization:              r.Organization.ValueInt64(),
+		NotificationType:          r.NotificationType.ValueString(),
+		NotificationConfiguration: jsonConfiguration,
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(notificationTemplate)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for notification template resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the notification template resource data from an AAP API response.
+// Secret fields of the configuration returned as $encrypted$ keep their configured value.
+func (r *NotificationTemplateResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiNotificationTemplate NotificationTemplateAPIModel
+	err := json.Unmarshal(body, &apiNotificationTemplate)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	configuration := apiNotificationTemplate.NotificationConfiguration
+	var apiConfiguration map[string]interface{}
+	if json.Unmarshal(configuration, &apiConfiguration) == nil && IsValueProvided(r.NotificationConfiguration) {
+		currentConfiguration, err := decodeJSONOrYAML(r.NotificationConfiguration.ValueString())
+		if currentObject, ok := currentConfiguration.(map[string]interface{}); err == nil && ok {
+			for key, value := range apiConfiguration {
+				if current, found := currentObject[key]; value == encryptedValue && found {
+					apiConfiguration[key] = current
+				}
+			}
+			configuration, _ = json.Marshal(apiConfiguration)
+		}
+	}
+
+	// Map response to the notification template resource schema and update attribute values
+	r.Id = types.Int64Value(apiNotificationTemplate.Id)
+	r.Url = types.StringValue(apiNotificationTemplate.Url)
+	r.Name = types.StringValue(apiNotificationTemplate.Name)
+	r.Description = ParseStringValue(apiNotificationTemplate.Description)
+	r.Organization = types.Int64Value(apiNotificationTemplate.Organization)
+	r.NotificationType = types.StringValue(apiNotificationTemplate.NotificationType)
+	r.NotificationConfiguration = parseJSONObjectValue(r.NotificationConfiguration, configuration)
+
+	return diags
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestNotificationTemplateResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the NotificationTemplateResource and call its Schema method
+	NewNotificationTemplateResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestNotificationTemplateResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    NotificationTemplateResourceModel
+		expected []byte
+	}{
+		{
+			name: "JSON configuration",
+			input: NotificationTemplateResourceModel{
+				Name:                      types.StringValue("alerts"),
+				Description:               types.StringNull(),
+				Organization:              types.Int64Value(1),
+				NotificationType:          types.StringValue("slack"),
+				NotificationConfiguration: customtypes.NewAAPCustomStringValue(`{"channels":["#ops"],"token":"xoxb"}`),
+			},
+			expected: []byte(`{"name":"alerts","description":"","organization":1,"notification_type":"slack",` +
+				`"notification_configuration":{"channels":["#ops"],"token":"xoxb"}}`),
+		},
+		{
+			name: "YAML configuration",
+			input: NotificationTemplateResourceModel{
+				Name:                      types.StringValue("alerts"),
+				Description:               types.StringValue("Webhook alerts"),
+				Organization:              types.Int64Value(2),
+				NotificationType:          types.StringValue("webhook"),
+				NotificationConfiguration: customtypes.NewAAPCustomStringValue("url: https://example.com\nhttp_method: POST"),
+			},
+			expected: []byte(`{"name":"alerts","description":"Webhook alerts","organization":2,"notification_type":"webhook",` +
+				`"notification_configuration":{"url":"https://example.com","http_method":"POST"}}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNotificationTemplateResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	response := []byte(`{"id":3,"url":"/api/v2/notification_templates/3/","name":"alerts","description":"",` +
+		`"organization":1,"notification_type":"slack","notification_configuration":{"channels":["#ops"],"token":"$encrypted$"}}`)
+
+	var testTable = []struct {
+		name     string
+		current  customtypes.AAPCustomStringValue
+		input    []byte
+		expected NotificationTemplateResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			current:  customtypes.NewAAPCustomStringNull(),
+			input:    []byte("Not valid JSON"),
+			expected: NotificationTemplateResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:    "configured secrets are kept",
+			current: customtypes.NewAAPCustomStringValue("channels:\n  - '#ops'\ntoken: xoxb"),
+			input:   response,
+			expected: NotificationTemplateResourceModel{
+				Id:                        types.Int64Value(3),
+				Url:                       types.StringValue("/api/v2/notification_templates/3/"),
+				Name:                      types.StringValue("alerts"),
+				Description:               types.StringNull(),
+				Organization:              types.Int64Value(1),
+				NotificationType:          types.StringValue("slack"),
+				NotificationConfiguration: customtypes.NewAAPCustomStringValue("channels:\n  - '#ops'\ntoken: xoxb"),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name:    "changed configuration",
+			current: customtypes.NewAAPCustomStringValue(`{"channels":["#dev"],"token":"xoxb"}`),
+			input:   response,
+			expected: NotificationTemplateResourceModel{
+				Id:                        types.Int64Value(3),
+				Url:                       types.StringValue("/api/v2/notification_templates/3/"),
+				Name:                      types.StringValue("alerts"),
+				Description:               types.StringNull(),
+				Organization:              types.Int64Value(1),
+				NotificationType:          types.StringValue("slack"),
+				NotificationConfiguration: customtypes.NewAAPCustomStringValue(`{"channels":["#ops"],"token":"xoxb"}`),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name:    "unknown secrets stay encrypted",
+			current: customtypes.NewAAPCustomStringNull(),
+			input:   response,
+			expected: NotificationTemplateResourceModel{
+				Id:                        types.Int64Value(3),
+				Url:                       types.StringValue("/api/v2/notification_templates/3/"),
+				Name:                      types.StringValue("alerts"),
+				Description:               types.StringNull(),
+				Organization:              types.Int64Value(1),
+				NotificationType:          types.StringValue("slack"),
+				NotificationConfiguration: customtypes.NewAAPCustomStringValue(`{"channels":["#ops"],"token":"$encrypted$"}`),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := NotificationTemplateResourceModel{NotificationConfiguration: test.current}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if test.errors.HasError() {
+				return
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccNotificationTemplateResource(t *testing.T) {
+	var notificationTemplate NotificationTemplateAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationTemplateResource(randomName, "https://example.com/create"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationTemplateResourceExists("aap_notification_template.test", &notificationTemplate),
+					resource.TestCheckResourceAttr("aap_notification_template.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_notification_template.test", "notification_type", "webhook"),
+					testAccCheckNotificationTemplateSecret(&notificationTemplate),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationTemplateResource(randomName, "https://example.com/update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationTemplateResourceExists("aap_notification_template.test", &notificationTemplate),
+					testAccCheckNotificationTemplateSecret(&notificationTemplate),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckNotificationTemplateResourceDestroy,
+	})
+}
+
+// testAccNotificationTemplateResource returns a configuration for an AAP Notification template sending webhooks to the provided URL.
+func testAccNotificationTemplateResource(name string, url string) string {
+	return fmt.Sprintf(`
+resource "aap_notification_template" "test" {
+  name              = "%s"
+  organization      = 1
+  notification_type = "webhook"
+  notification_configuration = jsonencode({
+    url                      = "%s"
+    http_method              = "POST"
+    headers                  = {}
+    username                 = "ansible"
+    password                 = "secret"
+    disable_ssl_verification = false
+  })
+}`, name, url)
+}
+
+// testAccCheckNotificationTemplateSecret verifies the password of the notification template is not returned by AAP.
+func testAccCheckNotificationTemplateSecret(notificationTemplate *NotificationTemplateAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var configuration map[string]interface{}
+		err := json.Unmarshal(notificationTemplate.NotificationConfiguration, &configuration)
+		if err != nil {
+			return err
+		}
+
+		if configuration["password"] != encryptedValue {
+			return fmt.Errorf("bad notification template password in AAP, expected \"%s\", got: %v", encryptedValue, configuration["password"])
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckNotificationTemplateResourceExists queries the AAP API and retrieves the matching notification template.
+func testAccCheckNotificationTemplateResourceExists(name string, notificationTemplate *NotificationTemplateAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		notificationTemplateResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("notification template (%s) not found in state", name)
+		}
+
+		notificationTemplateResponseBody, err := testGetResource(notificationTemplateResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(notificationTemplateResponseBody, &notificationTemplate)
+		if err != nil {
+			return err
+		}
+
+		if notificationTemplate.Id == 0 {
+			return fmt.Errorf("notification template (%s) not found in AAP", notificationTemplateResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckNotificationTemplateResourceDestroy verifies the notification template has been destroyed.
+func testAccCheckNotificationTemplateResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_notification_template" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("notification template (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &OrganizationDataSource{}
+	_ datasource.DataSourceWithConfigure = &OrganizationDataSource{}
+)
+
+// NewOrganizationDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationDataSource() datasource.DataSource {
+	return &OrganizationDataSource{}
+}
+
+// OrganizationDataSource is the data source implementation.
+type OrganizationDataSource struct {
+	client *AAPClient
+}
+
+// Metadata returns the data source type name.
+func (d *OrganizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the data source.
+func (d *OrganizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the organization",
+			},
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Organization id",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Url of the organization",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the organization",
+			},
+			"max_hosts": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum number of hosts allowed to be managed by the organization",
+			},
+			"default_environment": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Identifier for the default execution environment of the organization",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OrganizationDataSourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readResponseBody, diags := getResourceByName(d.client, "/api/v2/organizations/", state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = state.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *OrganizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// OrganizationDataSourceModel maps the data source schema data.
+type OrganizationDataSourceModel struct {
+	Id                 types.Int64  `tfsdk:"id"`
+	Url                types.String `tfsdk:"url"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	MaxHosts           types.Int64  `tfsdk:"max_hosts"`
+	DefaultEnvironment types.Int64  `tfsdk:"default_environment"`
+}
+
+func (d *OrganizationDataSourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiOrganization OrganizationAPIModel
+	err := json.Unmarshal(body, &apiOrganization)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the organization datasource schema
+	d.Id = types.Int64Value(apiOrganization.Id)
+	d.Url = types.StringValue(apiOrganization.Url)
+	d.Name = types.StringValue(apiOrganization.Name)
+	d.Description = ParseStringValue(apiOrganization.Description)
+	d.MaxHosts = types.Int64Value(apiOrganization.MaxHosts)
+	d.DefaultEnvironment = types.Int64PointerValue(apiOrganization.DefaultEnvironment)
+
+	return diags
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestOrganizationDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwdatasource.SchemaRequest{}
+	schemaResponse := &fwdatasource.SchemaResponse{}
+
+	// Instantiate the OrganizationDataSource and call its Schema method
+	NewOrganizationDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestOrganizationDataSourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected OrganizationDataSourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: OrganizationDataSourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "missing values",
+			input: []byte(`{"id":1,"name":"Default","url":"/api/v2/organizations/1/","max_hosts":0}`),
+			expected: OrganizationDataSourceModel{
+				Id:                 types.Int64Value(1),
+				Url:                types.StringValue("/api/v2/organizations/1/"),
+				Name:               types.StringValue("Default"),
+				Description:        types.StringNull(),
+				MaxHosts:           types.Int64Value(0),
+				DefaultEnvironment: types.Int64Null(),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "all values",
+			input: []byte(`{"id":1,"name":"Default","url":"/api/v2/organizations/1/","description":"The default organization",` +
+				`"max_hosts":50,"default_environment":2}`),
+			expected: OrganizationDataSourceModel{
+				Id:                 types.Int64Value(1),
+				Url:                types.StringValue("/api/v2/organizations/1/"),
+				Name:               types.StringValue("Default"),
+				Description:        types.StringValue("The default organization"),
+				MaxHosts:           types.Int64Value(50),
+				DefaultEnvironment: types.Int64Value(2),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := OrganizationDataSourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), Received (%s)", test.errors, diags)
+			}
+			if test.expected != resource {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestAccOrganizationDataSource(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create an organization and Read it by name
+			{
+				Config: testAccOrganizationDataSource(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aap_organization.test", "id", "aap_organization.test", "id"),
+					resource.TestCheckResourceAttrPair("data.aap_organization.test", "url", "aap_organization.test", "url"),
+					resource.TestCheckResourceAttr("data.aap_organization.test", "description", "A test organization"),
+					resource.TestCheckResourceAttr("data.aap_organization.test", "max_hosts", "5"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckOrganizationResourceDestroy,
+	})
+}
+
+// testAccOrganizationDataSource configures the Organization Data Source for testing
+func testAccOrganizationDataSource(name string) string {
+	return fmt.Sprintf(`
+resource "aap_organization" "test" {
+  name        = "%s"
+  description = "A test organization"
+  max_hosts   = 5
+}
+
+data "aap_organization" "test" {
+  name = aap_organization.test.name
+}
+`, name)
+}
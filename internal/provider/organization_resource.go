@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Organization AAP API model
+type OrganizationAPIModel struct {
+	Id                 int64  `json:"id,omitempty"`
+	Url                string `json:"url,omitempty"`
+	Name               string `json:"name"`
+	Description        string `json:"description,omitempty"`
+	MaxHosts           int64  `json:"max_hosts"`
+	DefaultEnvironment *int64 `json:"default_environment"`
+}
+
+// OrganizationResourceModel maps the organization resource schema to a Go struct.
+type OrganizationResourceModel struct {
+	Id                 types.Int64  `tfsdk:"id"`
+	Url                types.String `tfsdk:"url"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	MaxHosts           types.Int64  `tfsdk:"max_hosts"`
+	DefaultEnvironment types.Int64  `tfsdk:"default_environment"`
+	GalaxyCredentials  types.List   `tfsdk:"galaxy_credentials"`
+	InstanceGroups     types.List   `tfsdk:"instance_groups"`
+}
+
+// OrganizationResource is the resource implementation.
+type OrganizationResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &OrganizationResource{}
+	_ resource.ResourceWithConfigure = &OrganizationResource{}
+)
+
+// NewOrganizationResource is a helper function to simplify the provider implementation.
+func NewOrganizationResource() resource.Resource {
+	return &OrganizationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *OrganizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *OrganizationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the organization resource.
+func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Organization id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the organization",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the organization",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the organization",
+			},
+			"max_hosts": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Maximum number of hosts allowed to be managed by the organization. Defaults to 0, which means no limit.",
+			},
+			"default_environment": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the default execution environment of the organization.",
+			},
+			"galaxy_credentials": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Ordered list of Galaxy credential ids used by the organization to retrieve content. " +
+					"If not provided, the credentials assigned by AAP are left untouched.",
+			},
+			"instance_groups": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Ordered list of identifiers for the instance groups preferred to run jobs of the organization. " +
+					"If not provided, the instance groups assigned by AAP are left untouched.",
+			},
+		},
+	}
+}
+
+// Create creates the organization resource and sets the Terraform state on success.
+func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into organization resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from organization data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new organization in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/organizations/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new organization data into organization resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the created organization into Terraform state, so that it is tracked even if handling its Galaxy
+	// credentials or instance groups fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleGalaxyCredentials(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleInstanceGroups(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest organization data.
+func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into organization resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest organization data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest organization data into organization resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadGalaxyCredentials(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadInstanceGroups(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the organization resource and sets the updated Terraform state on success.
+func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into organization resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from organization data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update organization in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated organization data into organization resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleGalaxyCredentials(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleInstanceGroups(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the organization resource.
+func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into organization resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete organization from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the organization resource data
+func (r *OrganizationResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert organization resource data to API data model
+	organization := OrganizationAPIModel{
+		Name:        r.Name.ValueString(),
+		Description: r.Description.ValueString(),
+		MaxHosts:    r.MaxHosts.ValueInt64(),
+	}
+	if IsValueProvided(r.DefaultEnvironment) {
+		organization.DefaultEnvironment = r.DefaultEnvironment.ValueInt64Pointer()
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(organization)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for organization resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHttpResponse updates the organization resource data from an AAP API response
+func (r *OrganizationResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiOrganization OrganizationAPIModel
+	err := json.Unmarshal(body, &apiOrganization)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the organization resource schema and update attribute values
+	r.Id = types.Int64Value(apiOrganization.Id)
+	r.Url = types.StringValue(apiOrganization.Url)
+	r.Name = types.StringValue(apiOrganization.Name)
+	r.Description = ParseStringValue(apiOrganization.Description)
+	r.MaxHosts = types.Int64Value(apiOrganization.MaxHosts)
+	r.DefaultEnvironment = types.Int64PointerValue(apiOrganization.DefaultEnvironment)
+
+	return diags
+}
+
+// HandleGalaxyCredentials associates the Galaxy credentials from the plan with the organization, in order,
+// and saves the resulting credentials into the organization resource model.
+func (r *OrganizationResource) HandleGalaxyCredentials(ctx context.Context, data *OrganizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if IsValueProvided(data.GalaxyCredentials) {
+		credentials := make([]int64, 0, len(data.GalaxyCredentials.Elements()))
+		diags.Append(data.GalaxyCredentials.ElementsAs(ctx, &credentials, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		url, diagsURL := getURL(data.Url.ValueString(), "galaxy_credentials")
+		diags.Append(diagsURL...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(reconcileOrderedAssociation(r.client, url, credentials)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadGalaxyCredentials(ctx, data)...)
+	return diags
+}
+
+// ReadGalaxyCredentials saves the Galaxy credentials associated with the organization into the organization resource model.
+func (r *OrganizationResource) ReadGalaxyCredentials(ctx context.Context, data *OrganizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(data.Url.ValueString(), "galaxy_credentials")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	credentials, diagsRead := readAssociatedIDs(r.client, url)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	galaxyCredentials, diagsConvert := types.ListValueFrom(ctx, types.Int64Type, credentials)
+	diags.Append(diagsConvert...)
+	if diags.HasError() {
+		return diags
+	}
+	data.GalaxyCredentials = galaxyCredentials
+
+	return diags
+}
+
+// HandleInstanceGroups associates the instance groups from the plan with the organization, in order,
+// then saves them into the organization resource model.
+func (r *OrganizationResource) HandleInstanceGroups(ctx context.Context, data *OrganizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if IsValueProvided(data.InstanceGroups) {
+		instanceGroups := make([]int64, 0, len(data.InstanceGroups.Elements()))
+		diags.Append(data.InstanceGroups.ElementsAs(ctx, &instanceGroups, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		url, diagsURL := getURL(data.Url.ValueString(), "instance_groups")
+		diags.Append(diagsURL...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(reconcileOrderedAssociation(r.client, url, instanceGroups)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadInstanceGroups(ctx, data)...)
+	return diags
+}
+
+// ReadInstanceGroups saves the instance groups associated with the organization into the organization resource model.
+func (r *OrganizationResource) ReadInstanceGroups(ctx context.Context, data *OrganizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(data.Url.ValueString(), "instance_groups")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	instanceGroups, diagsRead := readAssociatedIDs(r.client, url)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	instanceGroupIds, diagsConvert := types.ListValueFrom(ctx, types.Int64Type, instanceGroups)
+	diags.Append(diagsConvert...)
+	if diags.HasError() {
+		return diags
+	}
+	data.InstanceGroups = instanceGroupIds
+
+	return diags
+}
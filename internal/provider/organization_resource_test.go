@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestOrganizationResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the OrganizationResource and call its Schema method
+	NewOrganizationResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestOrganizationResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    OrganizationResourceModel
+		expected []byte
+	}{
+		{
+			name: "test with unknown values",
+			input: OrganizationResourceModel{
+				Name:               types.StringValue("test organization"),
+				Description:        types.StringUnknown(),
+				MaxHosts:           types.Int64Unknown(),
+				DefaultEnvironment: types.Int64Unknown(),
+				Url:                types.StringUnknown(),
+			},
+			expected: []byte(`{"name":"test organization","max_hosts":0,"default_environment":null}`),
+		},
+		{
+			name: "test with null values",
+			input: OrganizationResourceModel{
+				Name:               types.StringValue("test organization"),
+				Description:        types.StringNull(),
+				MaxHosts:           types.Int64Value(0),
+				DefaultEnvironment: types.Int64Null(),
+			},
+			expected: []byte(`{"name":"test organization","max_hosts":0,"default_environment":null}`),
+		},
+		{
+			name: "test with all values",
+			input: OrganizationResourceModel{
+				Id:                 types.Int64Value(1),
+				Name:               types.StringValue("test organization"),
+				Description:        types.StringValue("A test organization"),
+				MaxHosts:           types.Int64Value(50),
+				DefaultEnvironment: types.Int64Value(2),
+				Url:                types.StringValue("/api/v2/organizations/1/"),
+			},
+			expected: []byte(
+				`{"name":"test organization","description":"A test organization","max_hosts":50,"default_environment":2}`,
+			),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if !bytes.Equal(test.expected, actual) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestOrganizationResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected OrganizationResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "test with JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: OrganizationResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "test with missing values",
+			input: []byte(`{"id":1,"name":"test organization","url":"/api/v2/organizations/1/","max_hosts":0,"default_environment":null}`),
+			expected: OrganizationResourceModel{
+				Id:                 types.Int64Value(1),
+				Url:                types.StringValue("/api/v2/organizations/1/"),
+				Name:               types.StringValue("test organization"),
+				Description:        types.StringNull(),
+				MaxHosts:           types.Int64Value(0),
+				DefaultEnvironment: types.Int64Null(),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "test with all values",
+			input: []byte(`{"id":1,"name":"test organization","url":"/api/v2/organizations/1/","description":"A test organization",` +
+				`"max_hosts":50,"default_environment":2}`),
+			expected: OrganizationResourceModel{
+				Id:                 types.Int64Value(1),
+				Url:                types.StringValue("/api/v2/organizations/1/"),
+				Name:               types.StringValue("test organization"),
+				Description:        types.StringValue("A test organization"),
+				MaxHosts:           types.Int64Value(50),
+				DefaultEnvironment: types.Int64Value(2),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := OrganizationResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccOrganizationResource(t *testing.T) {
+	var organization OrganizationAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	updatedName := "updated " + randomName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationResourceMinimal(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationResourceExists("aap_organization.test", &organization),
+					resource.TestCheckResourceAttr("aap_organization.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_organization.test", "max_hosts", "0"),
+					resource.TestCheckResourceAttrSet("aap_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("aap_organization.test", "url"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationResourceComplete(updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationResourceExists("aap_organization.test", &organization),
+					resource.TestCheckResourceAttr("aap_organization.test", "name", updatedName),
+					resource.TestCheckResourceAttr("aap_organization.test", "description", "A test organization"),
+					resource.TestCheckResourceAttr("aap_organization.test", "max_hosts", "10"),
+					resource.TestCheckResourceAttr("aap_organization.test", "galaxy_credentials.#", "0"),
+					resource.TestCheckResourceAttrPair("aap_inventory.test", "organization", "aap_organization.test", "id"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckOrganizationResourceDestroy,
+	})
+}
+
+// testAccOrganizationResourceMinimal returns a configuration for an AAP Organization with the provided name only.
+func testAccOrganizationResourceMinimal(name string) string {
+	return fmt.Sprintf(`
+resource "aap_organization" "test" {
+  name = "%s"
+}`, name)
+}
+
+// testAccOrganizationResourceComplete returns a configuration for an AAP Organization with all options and an inventory in it.
+func testAccOrganizationResourceComplete(name string) string {
+	return fmt.Sprintf(`
+resource "aap_organization" "test" {
+  name               = "%[1]s"
+  description        = "A test organization"
+  max_hosts          = 10
+  galaxy_credentials = []
+}
+
+resource "aap_inventory" "test" {
+  name         = "%[1]s"
+  organization = aap_organization.test.id
+}`, name)
+}
+
+// testAccCheckOrganizationResourceExists queries the AAP API and retrieves the matching organization.
+func testAccCheckOrganizationResourceExists(name string, organization *OrganizationAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		organizationResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("organization (%s) not found in state", name)
+		}
+
+		organizationResponseBody, err := testGetResource(organizationResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(organizationResponseBody, &organization)
+		if err != nil {
+			return err
+		}
+
+		if organization.Id == 0 {
+			return fmt.Errorf("organization (%s) not found in AAP", organizationResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckOrganizationResourceDestroy verifies the organization has been destroyed.
+func testAccCheckOrganizationResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_organization" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("organization (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,454 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Project AAP API model
+type ProjectAPIModel struct {
+	Id                int64  `json:"id,omitempty"`
+	Url               string `json:"url,omitempty"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	Organization      int64  `json:"organization"`
+	ScmType           string `json:"scm_type"`
+	ScmUrl            string `json:"scm_url"`
+	ScmBranch         string `json:"scm_branch"`
+	Credential        *int64 `json:"credential"`
+	ScmUpdateOnLaunch bool   `json:"scm_update_on_launch"`
+	AllowOverride     bool   `json:"allow_override"`
+	LocalPath         string `json:"local_path,omitempty"`
+}
+
+// ProjectResourceModel maps the project resource schema to a Go struct.
+type ProjectResourceModel struct {
+	Id                types.Int64  `tfsdk:"id"`
+	Url               types.String `tfsdk:"url"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Organization      types.Int64  `tfsdk:"organization"`
+	ScmType           types.String `tfsdk:"scm_type"`
+	ScmUrl            types.String `tfsdk:"scm_url"`
+	ScmBranch         types.String `tfsdk:"scm_branch"`
+	Credential        types.Int64  `tfsdk:"credential"`
+	ScmUpdateOnLaunch types.Bool   `tfsdk:"scm_update_on_launch"`
+	AllowOverride     types.Bool   `tfsdk:"allow_override"`
+	LocalPath         types.String `tfsdk:"local_path"`
+	UpdateOnApply     types.Bool   `tfsdk:"update_on_apply"`
+	Playbooks         types.List   `tfsdk:"playbooks"`
+}
+
+// ProjectResource is the resource implementation.
+type ProjectResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &ProjectResource{}
+	_ resource.ResourceWithConfigure = &ProjectResource{}
+)
+
+// NewProjectResource is a helper function to simplify the provider implementation.
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the project resource.
+func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Project id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the project",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the project",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the project",
+			},
+			"organization": schema.Int64Attribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier for the organization the project should be created in. " +
+					"If not provided, the project will be created in the default organization.",
+			},
+			"scm_type": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("git", "svn", "insights", "archive")},
+				Description: "Source control type of the project, one of git, svn, insights or archive. If not provided, the project is a manual project.",
+			},
+			"scm_url": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("scm_type")),
+				},
+				Description: "URL of the source control repository.",
+			},
+			"scm_branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Branch, tag or commit to checkout. If not provided, the default branch of the repository is used.",
+			},
+			"credential": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the source control credential used to access the repository.",
+			},
+			"scm_update_on_launch": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Update the project to the latest revision before each job runs. Defaults to false.",
+			},
+			"allow_override": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Allow job templates using the project to change the branch. Defaults to false.",
+			},
+			"local_path": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("scm_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Directory of a manual project, relative to the projects base path of AAP. " +
+					"For source control projects, this is the directory the repository is checked out to.",
+			},
+			"update_on_apply": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, update the project from source control after each create or update and wait for the update to succeed. Defaults to false.",
+			},
+			"playbooks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Playbooks detected in the project.",
+			},
+		},
+	}
+}
+
+// Create creates the project resource and sets the Terraform state on success.
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into project resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from project data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new project in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/projects/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new project data into project resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.UpdateProject(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest project data.
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into project resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest project data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest project data into project resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadPlaybooks(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the project resource and sets the updated Terraform state on success.
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into project resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from project data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update project in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated project data into project resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.UpdateProject(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the project resource.
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into project resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete project from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the project resource data
+func (r *ProjectResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Use default organization if not provided
+	organizationId := r.Organization.ValueInt64()
+	if organizationId == 0 {
+		organizationId = 1
+	}
+
+	// Convert project resource data to API data model
+	project := ProjectAPIModel{
+		Name:              r.Name.ValueString(),
+		Description:       r.Description.ValueString(),
+		Organization:      organizationId,
+		ScmType:           r.ScmType.ValueString(),
+		ScmUrl:            r.ScmUrl.ValueString(),
+		ScmBranch:         r.ScmBranch.ValueString(),
+		Credential:        r.Credential.ValueInt64Pointer(),
+		ScmUpdateOnLaunch: r.ScmUpdateOnLaunch.ValueBool(),
+		AllowOverride:     r.AllowOverride.ValueBool(),
+	}
+
+	// The local path of source control projects is managed by AAP
+	if !IsValueProvided(r.ScmType) {
+		project.LocalPath = r.LocalPath.ValueString()
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(project)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for project resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHttpResponse updates the project resource data from an AAP API response
+func (r *ProjectResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiProject ProjectAPIModel
+	err := json.Unmarshal(body, &apiProject)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the project resource schema and update attribute values
+	r.Id = types.Int64Value(apiProject.Id)
+	r.Url = types.StringValue(apiProject.Url)
+	r.Name = types.StringValue(apiProject.Name)
+	r.Description = ParseStringValue(apiProject.Description)
+	r.Organization = types.Int64Value(apiProject.Organization)
+	r.ScmType = ParseStringValue(apiProject.ScmType)
+	r.ScmUrl = ParseStringValue(apiProject.ScmUrl)
+	r.ScmBranch = ParseStringValue(apiProject.ScmBranch)
+	r.Credential = types.Int64PointerValue(apiProject.Credential)
+	r.ScmUpdateOnLaunch = types.BoolValue(apiProject.ScmUpdateOnLaunch)
+	r.AllowOverride = types.BoolValue(apiProject.AllowOverride)
+	r.LocalPath = ParseStringValue(apiProject.LocalPath)
+
+	return diags
+}
+
+// ParsePlaybooks updates the project playbooks from an AAP API response
+func (r *ProjectResourceModel) ParsePlaybooks(ctx context.Context, body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	playbooks := []string{}
+	err := json.Unmarshal(body, &playbooks)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	r.Playbooks, diags = types.ListValueFrom(ctx, types.StringType, playbooks)
+	return diags
+}
+
+// ReadPlaybooks saves the playbooks detected in the project into the project resource model.
+func (r *ProjectResource) ReadPlaybooks(ctx context.Context, data *ProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(data.Url.ValueString(), "playbooks")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	body, diagsGet := r.client.Get(url)
+	diags.Append(diagsGet...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.ParsePlaybooks(ctx, body)...)
+	return diags
+}
+
+// UpdateProject launches a project update and waits for it to succeed when requested,
+// then saves the playbooks detected in the project into the project resource model.
+func (r *ProjectResource) UpdateProject(ctx context.Context, data *ProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.UpdateOnApply.ValueBool() {
+		diags.Append(launchUpdateAndWait(r.client, data.Url.ValueString(), "project_update", "Project update")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadPlaybooks(ctx, data)...)
+	return diags
+}
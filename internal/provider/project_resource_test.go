@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestProjectResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the ProjectResource and call its Schema method
+	NewProjectResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestProjectResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    ProjectResourceModel
+		expected []byte
+	}{
+		{
+			name: "manual project",
+			input: ProjectResourceModel{
+				Name:              types.StringValue("test project"),
+				Description:       types.StringNull(),
+				Organization:      types.Int64Unknown(),
+				ScmType:           types.StringNull(),
+				ScmUrl:            types.StringNull(),
+				ScmBranch:         types.StringNull(),
+				Credential:        types.Int64Null(),
+				ScmUpdateOnLaunch: types.BoolValue(false),
+				AllowOverride:     types.BoolValue(false),
+				LocalPath:         types.StringValue("my_playbooks"),
+			},
+			expected: []byte(`{"name":"test project","description":"","organization":1,"scm_type":"","scm_url":"",` +
+				`"scm_branch":"","credential":null,"scm_update_on_launch":false,"allow_override":false,"local_path":"my_playbooks"}`),
+		},
+		{
+			name: "git project",
+			input: ProjectResourceModel{
+				Name:              types.StringValue("test project"),
+				Description:       types.StringValue("A test project"),
+				Organization:      types.Int64Value(2),
+				ScmType:           types.StringValue("git"),
+				ScmUrl:            types.StringValue("https://github.com/ansible/ansible-tower-samples"),
+				ScmBranch:         types.StringValue("master"),
+				Credential:        types.Int64Value(3),
+				ScmUpdateOnLaunch: types.BoolValue(true),
+				AllowOverride:     types.BoolValue(true),
+				LocalPath:         types.StringUnknown(),
+			},
+			expected: []byte(`{"name":"test project","description":"A test project","organization":2,"scm_type":"git",` +
+				`"scm_url":"https://github.com/ansible/ansible-tower-samples","scm_branch":"master","credential":3,` +
+				`"scm_update_on_launch":true,"allow_override":true}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestProjectResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected ProjectResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: ProjectResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "git project",
+			input: []byte(`{"id":6,"url":"/api/v2/projects/6/","name":"test project","description":"","organization":1,` +
+				`"scm_type":"git","scm_url":"https://github.com/ansible/ansible-tower-samples","scm_branch":"","credential":null,` +
+				`"scm_update_on_launch":true,"allow_override":false,"local_path":"_6__test_project"}`),
+			expected: ProjectResourceModel{
+				Id:                types.Int64Value(6),
+				Url:               types.StringValue("/api/v2/projects/6/"),
+				Name:              types.StringValue("test project"),
+				Description:       types.StringNull(),
+				Organization:      types.Int64Value(1),
+				ScmType:           types.StringValue("git"),
+				ScmUrl:            types.StringValue("https://github.com/ansible/ansible-tower-samples"),
+				ScmBranch:         types.StringNull(),
+				Credential:        types.Int64Null(),
+				ScmUpdateOnLaunch: types.BoolValue(true),
+				AllowOverride:     types.BoolValue(false),
+				LocalPath:         types.StringValue("_6__test_project"),
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name: "manual project",
+			input: []byte(`{"id":7,"url":"/api/v2/projects/7/","name":"test project","description":"A test project",` +
+				`"organization":2,"scm_type":"","scm_url":"","scm_branch":"","credential":null,` +
+				`"scm_update_on_launch":false,"allow_override":false,"local_path":"my_playbooks"}`),
+			expected: ProjectResourceModel{
+				Id:                types.Int64Value(7),
+				Url:               types.StringValue("/api/v2/projects/7/"),
+				Name:              types.StringValue("test project"),
+				Description:       types.StringValue("A test project"),
+				Organization:      types.Int64Value(2),
+				ScmType:           types.StringNull(),
+				ScmUrl:            types.StringNull(),
+				ScmBranch:         types.StringNull(),
+				Credential:        types.Int64Null(),
+				ScmUpdateOnLaunch: types.BoolValue(false),
+				AllowOverride:     types.BoolValue(false),
+				LocalPath:         types.StringValue("my_playbooks"),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := ProjectResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestProjectResourceParsePlaybooks(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected types.List
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: types.List{},
+			errors:   jsonError,
+		},
+		{
+			name:     "no playbooks",
+			input:    []byte(`[]`),
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+			errors:   nil,
+		},
+		{
+			name:  "playbooks",
+			input: []byte(`["hello_world.yml","site.yml"]`),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("hello_world.yml"),
+				types.StringValue("site.yml"),
+			}),
+			errors: nil,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := ProjectResourceModel{}
+			diags := resource.ParsePlaybooks(context.Background(), test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource.Playbooks) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource.Playbooks)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func testAccProjectResourcePreCheck(t *testing.T) {
+	// Ensure provider requirements
+	testAccPreCheck(t)
+
+	if v := os.Getenv("AAP_TEST_PROJECT_SCM_URL"); v == "" {
+		t.Fatal("'AAP_TEST_PROJECT_SCM_URL' environment variable must be set when running acceptance tests for project resource")
+	}
+}
+
+func TestAccProjectResource(t *testing.T) {
+	var project ProjectAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	scmURL := os.Getenv("AAP_TEST_PROJECT_SCM_URL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccProjectResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResource(randomName, scmURL, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectResourceExists("aap_project.test", &project),
+					resource.TestCheckResourceAttr("aap_project.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_project.test", "scm_type", "git"),
+					resource.TestCheckResourceAttr("aap_project.test", "scm_url", scmURL),
+					resource.TestCheckResourceAttr("aap_project.test", "organization", "1"),
+					resource.TestCheckResourceAttrSet("aap_project.test", "local_path"),
+				),
+			},
+			// Update with a project update and Read testing
+			{
+				Config: testAccProjectResource(randomName, scmURL, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectResourceExists("aap_project.test", &project),
+					resource.TestCheckResourceAttr("aap_project.test", "scm_update_on_launch", "true"),
+					resource.TestCheckResourceAttrWith("aap_project.test", "playbooks.#", func(value string) error {
+						if value == "0" {
+							return fmt.Errorf("expected playbooks to be detected after the project update")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckProjectResourceDestroy,
+	})
+}
+
+// testAccProjectResource returns a configuration for an AAP git Project, optionally updated on apply.
+func testAccProjectResource(name string, scmURL string, update bool) string {
+	return fmt.Sprintf(`
+resource "aap_project" "test" {
+  name                 = "%s"
+  scm_type             = "git"
+  scm_url              = "%s"
+  scm_update_on_launch = %[3]t
+  update_on_apply      = %[3]t
+}`, name, scmURL, update)
+}
+
+// testAccCheckProjectResourceExists queries the AAP API and retrieves the matching project.
+func testAccCheckProjectResourceExists(name string, project *ProjectAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		projectResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("project (%s) not found in state", name)
+		}
+
+		projectResponseBody, err := testGetResource(projectResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(projectResponseBody, &project)
+		if err != nil {
+			return err
+		}
+
+		if project.Id == 0 {
+			return fmt.Errorf("project (%s) not found in AAP", projectResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckProjectResourceDestroy verifies the project has been destroyed.
+func testAccCheckProjectResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_project" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("project (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -153,6 +153,8 @@ func (p *aapProvider) Configure(ctx context.Context, req provider.ConfigureReque
 func (p *aapProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewInventoryDataSource,
+		NewOrganizationDataSource,
+		NewExecutionEnvironmentDataSource,
 	}
 }
 
@@ -163,6 +165,29 @@ func (p *aapProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewJobResource,
 		NewGroupResource,
 		NewHostResource,
+		NewBulkJobLaunchResource,
+		NewSystemJobResource,
+		NewOrganizationResource,
+		NewJobTemplateResource,
+		NewProjectResource,
+		NewCredentialResource,
+		NewCredentialTypeResource,
+		NewCredentialInputSourceResource,
+		NewInventorySourceResource,
+		NewExecutionEnvironmentResource,
+		NewInstanceGroupResource,
+		NewScheduleResource,
+		NewNotificationTemplateResource,
+		NewNotificationTemplateAttachmentResource,
+		NewUserResource,
+		NewTeamResource,
+		NewTeamMembershipResource,
+		NewRoleAssignmentResource,
+		NewLabelResource,
+		NewWorkflowJobTemplateResource,
+		NewSurveySpecResource,
+		NewWorkflowApprovalResource,
+		NewSettingsResource,
 	}
 }
 
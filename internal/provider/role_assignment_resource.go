@@ -0,0 +1,549 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// roleAssignmentObject describes a type of object roles can be granted on.
+type roleAssignmentObject struct {
+	attribute  string
+	collection string
+	// roles maps the names of the roles of the object to the names of the matching role definitions. Roles without
+	// a role definition have an empty name, they are always granted by associating the role of the object.
+	roles map[string]string
+}
+
+// roleAssignmentObjects are the types of objects roles can be granted on, with the name of their AAP API collection
+// and the roles that can be granted on them.
+var roleAssignmentObjects = []roleAssignmentObject{
+	{
+		attribute:  "inventory",
+		collection: "inventories",
+		roles: map[string]string{
+			"admin":  "Inventory Admin",
+			"read":   "",
+			"use":    "Inventory Use",
+			"adhoc":  "Inventory Adhoc",
+			"update": "Inventory Update",
+		},
+	},
+	{
+		attribute:  "job_template",
+		collection: "job_templates",
+		roles: map[string]string{
+			"admin":   "JobTemplate Admin",
+			"read":    "",
+			"execute": "JobTemplate Execute",
+		},
+	},
+	{
+		attribute:  "credential",
+		collection: "credentials",
+		roles: map[string]string{
+			"admin": "Credential Admin",
+			"read":  "",
+			"use":   "Credential Use",
+		},
+	},
+	{
+		attribute:  "project",
+		collection: "projects",
+		roles: map[string]string{
+			"admin":  "Project Admin",
+			"read":   "",
+			"use":    "Project Use",
+			"update": "Project Update",
+		},
+	},
+	{
+		attribute:  "organization",
+		collection: "organizations",
+		roles: map[string]string{
+			"admin":                       "Organization Admin",
+			"read":                        "",
+			"member":                      "Organization Member",
+			"auditor":                     "Organization Audit",
+			"project_admin":               "Organization Project Admin",
+			"inventory_admin":             "Organization Inventory Admin",
+			"credential_admin":            "Organization Credential Admin",
+			"job_template_admin":          "Organization JobTemplate Admin",
+			"workflow_admin":              "Organization WorkflowJobTemplate Admin",
+			"notification_admin":          "Organization NotificationTemplate Admin",
+			"execution_environment_admin": "Organization ExecutionEnvironment Admin",
+		},
+	},
+}
+
+// supportedRoles returns the sorted names of the roles that can be granted on the object.
+func (o roleAssignmentObject) supportedRoles() []string {
+	roles := make([]string, 0, len(o.roles))
+	for role := range o.roles {
+		roles = append(roles, role)
+	}
+	slices.Sort(roles)
+	return roles
+}
+
+// Role assignment AAP API model, used by the role definitions API.
+type RoleAssignmentAPIModel struct {
+	Id             int64  `json:"id,omitempty"`
+	Url            string `json:"url,omitempty"`
+	RoleDefinition int64  `json:"role_definition"`
+	User           *int64 `json:"user,omitempty"`
+	Team           *int64 `json:"team,omitempty"`
+	ObjectId       string `json:"object_id"`
+}
+
+// RoleAssignmentResourceModel maps the role assignment resource schema to a Go struct.
+type RoleAssignmentResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Role           types.String `tfsdk:"role"`
+	Inventory      types.Int64  `tfsdk:"inventory"`
+	JobTemplate    types.Int64  `tfsdk:"job_template"`
+	Credential     types.Int64  `tfsdk:"credential"`
+	Project        types.Int64  `tfsdk:"project"`
+	Organization   types.Int64  `tfsdk:"organization"`
+	User           types.Int64  `tfsdk:"user"`
+	Team           types.Int64  `tfsdk:"team"`
+	RoleId         types.Int64  `tfsdk:"role_id"`
+	RoleDefinition types.Int64  `tfsdk:"role_definition"`
+}
+
+// RoleAssignmentResource is the resource implementation.
+type RoleAssignmentResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &RoleAssignmentResource{}
+	_ resource.ResourceWithConfigure      = &RoleAssignmentResource{}
+	_ resource.ResourceWithValidateConfig = &RoleAssignmentResource{}
+)
+
+// NewRoleAssignmentResource is a helper function to simplify the provider implementation.
+func NewRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *RoleAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignment"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RoleAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the role assignment resource.
+func (r *RoleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	objects := make([]path.Expression, 0, len(roleAssignmentObjects))
+	for _, object := range roleAssignmentObjects {
+		objects = append(objects, path.MatchRoot(object.attribute))
+	}
+	objectAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional:   true,
+			Validators: []validator.Int64{int64validator.ExactlyOneOf(objects...)},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Description: description + " Exactly one of inventory, job_template, credential, project or organization must be provided.",
+		}
+	}
+	assigneeAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(path.MatchRoot("user"), path.MatchRoot("team")),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Description: description + " Exactly one of user or team must be provided.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier of the role assignment. It is the URL of the assignment when the role definitions " +
+					"API is used, or else the URL the role was associated at followed by the associated id.",
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the granted role, such as admin, execute, use, read or member. The supported roles " +
+					"depend on the type of object. With the role definitions API, the matching role definition is " +
+					"granted, such as JobTemplate Execute. The read role has no role definition and is always granted " +
+					"through the role of the object.",
+			},
+			"inventory":    objectAttribute("Identifier for the inventory the role is granted on."),
+			"job_template": objectAttribute("Identifier for the job template the role is granted on."),
+			"credential":   objectAttribute("Identifier for the credential the role is granted on."),
+			"project":      objectAttribute("Identifier for the project the role is granted on."),
+			"organization": objectAttribute("Identifier for the organization the role is granted on."),
+			"user":         assigneeAttribute("Identifier for the user the role is granted to."),
+			"team":         assigneeAttribute("Identifier for the team the role is granted to."),
+			"role_id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier for the granted role of the object, when the role definitions API is not available.",
+			},
+			"role_definition": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier for the granted role definition, when the role definitions API is available.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the role can be granted on the type of object.
+func (r *RoleAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object, _ := data.Object()
+	if object.attribute == "" || data.Role.IsNull() || data.Role.IsUnknown() {
+		return
+	}
+	if _, ok := object.roles[data.Role.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("role"), "Unsupported role",
+			fmt.Sprintf("The %s role can not be granted on %s, supported roles are: %s.",
+				data.Role.ValueString(), object.attribute, strings.Join(object.supportedRoles(), ", ")))
+	}
+}
+
+// Create grants the role and sets the Terraform state on success.
+func (r *RoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleAssignmentResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into role assignment resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Grant role in AAP, with the role definitions API when available and the role has a role definition
+	supported, diags := roleDefinitionsSupported(r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if supported && data.RoleDefinitionName() != "" {
+		resp.Diagnostics.Append(r.assignRoleDefinition(&data)...)
+	} else {
+		resp.Diagnostics.Append(r.assignRole(&data)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state, removing the role assignment when the role is no longer granted.
+func (r *RoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read current Terraform state data into role assignment resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get role assignment from AAP
+	var granted bool
+	if !data.RoleDefinition.IsNull() {
+		getResponse, body, err := r.client.doRequest(http.MethodGet, data.Id.ValueString(), nil)
+		granted = getResponse == nil || getResponse.StatusCode != http.StatusNotFound
+		if granted {
+			resp.Diagnostics.Append(ValidateResponse(getResponse, body, err, []int{http.StatusOK})...)
+		}
+	} else {
+		associationURL, associatedID := data.AssociationURL()
+		associatedIDs, diags := readAssociatedIDs(r.client, associationURL)
+		resp.Diagnostics.Append(diags...)
+		granted = slices.Contains(associatedIDs, associatedID)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !granted {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+// Update is never called, since changing any attribute replaces the role assignment.
+func (r *RoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleAssignmentResourceModel
+
+	// Read Terraform plan data into role assignment resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes the role.
+func (r *RoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleAssignmentResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into role assignment resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revoke role in AAP
+	if !data.RoleDefinition.IsNull() {
+		_, diags = r.client.Delete(data.Id.ValueString())
+	} else {
+		associationURL, associatedID := data.AssociationURL()
+		diags = associateIDs(r.client, associationURL, []int64{associatedID}, true)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// assignRoleDefinition grants the role definition named after the object type and the role, through the role
+// definitions API.
+func (r *RoleAssignmentResource) assignRoleDefinition(data *RoleAssignmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	roleDefinitionBody, diags := getResourceByName(r.client, "/api/v2/role_definitions/", data.RoleDefinitionName())
+	if diags.HasError() {
+		return diags
+	}
+	var roleDefinition struct {
+		Id int64 `json:"id"`
+	}
+	err := json.Unmarshal(roleDefinitionBody, &roleDefinition)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+	data.RoleDefinition = types.Int64Value(roleDefinition.Id)
+	data.RoleId = types.Int64Null()
+
+	// Create request body from role assignment data
+	createRequestBody, diags := data.CreateRequestBody()
+	if diags.HasError() {
+		return diags
+	}
+
+	assignmentsPath := "/api/v2/role_user_assignments/"
+	if data.User.IsNull() {
+		assignmentsPath = "/api/v2/role_team_assignments/"
+	}
+	createResponseBody, diags := r.client.Create(assignmentsPath, bytes.NewReader(createRequestBody))
+	if diags.HasError() {
+		return diags
+	}
+
+	var assignment RoleAssignmentAPIModel
+	err = json.Unmarshal(createResponseBody, &assignment)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+	data.Id = types.StringValue(assignment.Url)
+
+	return diags
+}
+
+// assignRole grants the role of the object to the user, or the team, by associating them.
+func (r *RoleAssignmentResource) assignRole(data *RoleAssignmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	object, objectID := data.Object()
+	objectBody, diags := r.client.Get(fmt.Sprintf("/api/v2/%s/%d/", object.collection, objectID))
+	if diags.HasError() {
+		return diags
+	}
+	roleID, err := parseObjectRoleID(objectBody, data.Role.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Role not found",
+			fmt.Sprintf("Could not find the %s role of %s %d: %s", data.Role.ValueString(), object.attribute, objectID, err.Error()),
+		)
+		return diags
+	}
+	data.RoleId = types.Int64Value(roleID)
+	data.RoleDefinition = types.Int64Null()
+
+	associationURL, associatedID := data.AssociationURL()
+	diags = associateIDs(r.client, associationURL, []int64{associatedID}, false)
+	if diags.HasError() {
+		return diags
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s%d", associationURL, associatedID))
+
+	return diags
+}
+
+// Object returns the type and the id of the object the role is granted on.
+func (r *RoleAssignmentResourceModel) Object() (roleAssignmentObject, int64) {
+	values := map[string]types.Int64{
+		"inventory":    r.Inventory,
+		"job_template": r.JobTemplate,
+		"credential":   r.Credential,
+		"project":      r.Project,
+		"organization": r.Organization,
+	}
+	for _, object := range roleAssignmentObjects {
+		if value := values[object.attribute]; !value.IsNull() {
+			return object, value.ValueInt64()
+		}
+	}
+	return roleAssignmentObject{}, 0
+}
+
+// RoleDefinitionName returns the name of the role definition matching the role, granted with the role definitions
+// API, such as JobTemplate Execute. It is empty when the role has no role definition, such as read.
+func (r *RoleAssignmentResourceModel) RoleDefinitionName() string {
+	object, _ := r.Object()
+	return object.roles[r.Role.ValueString()]
+}
+
+// AssociationURL returns the URL the role is associated at without the role definitions API, and the associated id:
+// the user is associated to the role, or the role is associated to the team.
+func (r *RoleAssignmentResourceModel) AssociationURL() (string, int64) {
+	if !r.User.IsNull() {
+		return fmt.Sprintf("/api/v2/roles/%d/users/", r.RoleId.ValueInt64()), r.User.ValueInt64()
+	}
+	return fmt.Sprintf("/api/v2/teams/%d/roles/", r.Team.ValueInt64()), r.RoleId.ValueInt64()
+}
+
+// CreateRequestBody creates a JSON encoded request body from the role assignment resource data
+func (r *RoleAssignmentResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	_, objectID := r.Object()
+
+	// Convert role assignment resource data to API data model
+	assignment := RoleAssignmentAPIModel{
+		RoleDefinition: r.RoleDefinition.ValueInt64(),
+		User:           r.User.ValueInt64Pointer(),
+		Team:           r.Team.ValueInt64Pointer(),
+		ObjectId:       strconv.FormatInt(objectID, 10),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(assignment)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for role assignment resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// parseObjectRoleID returns the id of the named role from the object roles listed in the summary fields of an object.
+func parseObjectRoleID(body []byte, role string) (int64, error) {
+	var object struct {
+		SummaryFields struct {
+			ObjectRoles map[string]struct {
+				Id int64 `json:"id"`
+			} `json:"object_roles"`
+		} `json:"summary_fields"`
+	}
+	err := json.Unmarshal(body, &object)
+	if err != nil {
+		return 0, err
+	}
+
+	objectRole, ok := object.SummaryFields.ObjectRoles[role+"_role"]
+	if !ok {
+		available := make([]string, 0, len(object.SummaryFields.ObjectRoles))
+		for name := range object.SummaryFields.ObjectRoles {
+			available = append(available, strings.TrimSuffix(name, "_role"))
+		}
+		slices.Sort(available)
+		return 0, fmt.Errorf("available roles are %s", strings.Join(available, ", "))
+	}
+
+	return objectRole.Id, nil
+}
+
+// roleDefinitionsSupported returns whether the AAP API provides the role definitions API.
+func roleDefinitionsSupported(client ProviderHTTPClient) (bool, diag.Diagnostics) {
+	body, diags := client.Get("/api/v2/")
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var endpoints map[string]json.RawMessage
+	err := json.Unmarshal(body, &endpoints)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return false, diags
+	}
+
+	_, supported := endpoints["role_definitions"]
+	return supported, diags
+}
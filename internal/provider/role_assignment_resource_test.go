@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestRoleAssignmentResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the RoleAssignmentResource and call its Schema method
+	NewRoleAssignmentResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// newTestRoleAssignmentResourceModel returns a role assignment resource model without object nor assignee.
+func newTestRoleAssignmentResourceModel(role string) RoleAssignmentResourceModel {
+	return RoleAssignmentResourceModel{
+		Role:           types.StringValue(role),
+		Inventory:      types.Int64Null(),
+		JobTemplate:    types.Int64Null(),
+		Credential:     types.Int64Null(),
+		Project:        types.Int64Null(),
+		Organization:   types.Int64Null(),
+		User:           types.Int64Null(),
+		Team:           types.Int64Null(),
+		RoleId:         types.Int64Null(),
+		RoleDefinition: types.Int64Null(),
+	}
+}
+
+func TestRoleAssignmentResourceRoleDefinitionName(t *testing.T) {
+	t.Parallel()
+
+	jobTemplate := newTestRoleAssignmentResourceModel("execute")
+	jobTemplate.JobTemplate = types.Int64Value(7)
+	inventory := newTestRoleAssignmentResourceModel("adhoc")
+	inventory.Inventory = types.Int64Value(2)
+	organization := newTestRoleAssignmentResourceModel("execution_environment_admin")
+	organization.Organization = types.Int64Value(1)
+	project := newTestRoleAssignmentResourceModel("read")
+	project.Project = types.Int64Value(4)
+
+	var testTable = []struct {
+		name     string
+		input    RoleAssignmentResourceModel
+		expected string
+	}{
+		{"job template", jobTemplate, "JobTemplate Execute"},
+		{"inventory", inventory, "Inventory Adhoc"},
+		{"multiple words", organization, "Organization ExecutionEnvironment Admin"},
+		{"role without role definition", project, ""},
+		{"unsupported role", newTestRoleAssignmentResourceModel("execute"), ""},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.input.RoleDefinitionName()
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRoleAssignmentResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewRoleAssignmentResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	jobTemplate := newTestRoleAssignmentResourceModel("execute")
+	jobTemplate.JobTemplate = types.Int64Value(7)
+	inventory := newTestRoleAssignmentResourceModel("execute")
+	inventory.Inventory = types.Int64Value(2)
+	read := newTestRoleAssignmentResourceModel("read")
+	read.Credential = types.Int64Value(3)
+	unknownRole := newTestRoleAssignmentResourceModel("")
+	unknownRole.Role = types.StringUnknown()
+	unknownRole.Inventory = types.Int64Value(2)
+
+	var testTable = []struct {
+		name     string
+		input    RoleAssignmentResourceModel
+		expected []string
+	}{
+		{"supported role", jobTemplate, nil},
+		{"read role", read, nil},
+		{"unsupported role", inventory, []string{
+			"The execute role can not be granted on inventory, supported roles are: adhoc, admin, read, update, use.",
+		}},
+		{"unknown role", unknownRole, nil},
+		{"no object", newTestRoleAssignmentResourceModel("execute"), nil},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			state := tfsdk.State{
+				Schema: schemaResponse.Schema,
+				Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := state.Set(ctx, &test.input)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+
+			req := fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}
+			resp := &fwresource.ValidateConfigResponse{}
+			NewRoleAssignmentResource().(*RoleAssignmentResource).ValidateConfig(ctx, req, resp)
+
+			var actual []string
+			for _, err := range resp.Diagnostics.Errors() {
+				actual = append(actual, err.Detail())
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected errors (%v) not equal to actual (%v)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRoleAssignmentResourceCreateReadRole(t *testing.T) {
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewRoleAssignmentResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	// The role definitions API is available, but the read role has no role definition
+	r := RoleAssignmentResource{client: NewMockRawHTTPClient(map[string]MockRawResponse{
+		"GET /api/v2/": {
+			StatusCode: http.StatusOK,
+			Body:       `{"role_definitions":"/api/v2/role_definitions/"}`,
+		},
+		"GET /api/v2/projects/4/": {
+			StatusCode: http.StatusOK,
+			Body:       `{"id":4,"summary_fields":{"object_roles":{"admin_role":{"id":39},"read_role":{"id":40}}}}`,
+		},
+		"POST /api/v2/roles/40/users/": {StatusCode: http.StatusNoContent},
+	})}
+
+	data := newTestRoleAssignmentResourceModel("read")
+	data.Id = types.StringUnknown()
+	data.Project = types.Int64Value(4)
+	data.User = types.Int64Value(5)
+	data.RoleId = types.Int64Unknown()
+	data.RoleDefinition = types.Int64Unknown()
+
+	plan := tfsdk.Plan{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, &data)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	req := fwresource.CreateRequest{Plan: plan}
+	resp := &fwresource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics.Errors())
+	}
+
+	var actual RoleAssignmentResourceModel
+	diags = resp.State.Get(ctx, &actual)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	expected := data
+	expected.Id = types.StringValue("/api/v2/roles/40/users/5")
+	expected.RoleId = types.Int64Value(40)
+	expected.RoleDefinition = types.Int64Null()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, actual)
+	}
+}
+
+func TestRoleAssignmentResourceAssociationURL(t *testing.T) {
+	t.Parallel()
+
+	user := newTestRoleAssignmentResourceModel("admin")
+	user.User = types.Int64Value(4)
+	user.RoleId = types.Int64Value(30)
+	team := newTestRoleAssignmentResourceModel("admin")
+	team.Team = types.Int64Value(5)
+	team.RoleId = types.Int64Value(30)
+
+	var testTable = []struct {
+		name               string
+		input              RoleAssignmentResourceModel
+		expectedURL        string
+		expectedAssociated int64
+	}{
+		{"user", user, "/api/v2/roles/30/users/", 4},
+		{"team", team, "/api/v2/teams/5/roles/", 30},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			url, associated := test.input.AssociationURL()
+			if url != test.expectedURL || associated != test.expectedAssociated {
+				t.Errorf("Expected (%s, %d) not equal to actual (%s, %d)", test.expectedURL, test.expectedAssociated, url, associated)
+			}
+		})
+	}
+}
+
+func TestRoleAssignmentResourceCreateRequestBody(t *testing.T) {
+	user := newTestRoleAssignmentResourceModel("execute")
+	user.JobTemplate = types.Int64Value(7)
+	user.User = types.Int64Value(4)
+	user.RoleDefinition = types.Int64Value(12)
+	team := newTestRoleAssignmentResourceModel("use")
+	team.Credential = types.Int64Value(3)
+	team.Team = types.Int64Value(5)
+	team.RoleDefinition = types.Int64Value(9)
+
+	var testTable = []struct {
+		name     string
+		input    RoleAssignmentResourceModel
+		expected []byte
+	}{
+		{"user", user, []byte(`{"role_definition":12,"user":4,"object_id":"7"}`)},
+		{"team", team, []byte(`{"role_definition":9,"team":5,"object_id":"3"}`)},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseObjectRoleID(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"id":7,"summary_fields":{"object_roles":{"admin_role":{"id":30,"name":"Admin"},` +
+		`"execute_role":{"id":31,"name":"Execute"},"read_role":{"id":32,"name":"Read"}}}}`)
+
+	var testTable = []struct {
+		role          string
+		expected      int64
+		expectedError string
+	}{
+		{"execute", 31, ""},
+		{"read", 32, ""},
+		{"use", 0, "available roles are admin, execute, read"},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.role, func(t *testing.T) {
+			actual, err := parseObjectRoleID(body, test.role)
+			if test.expectedError != "" {
+				if err == nil || err.Error() != test.expectedError {
+					t.Errorf("Expected error (%s), got (%v)", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != test.expected {
+				t.Errorf("Expected (%d) not equal to actual (%d)", test.expected, actual)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccRoleAssignmentResource(t *testing.T) {
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleAssignmentResource(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRoleAssignmentExists("aap_role_assignment.test"),
+					resource.TestCheckResourceAttr("aap_role_assignment.test", "role", "member"),
+					resource.TestCheckResourceAttrPair("aap_role_assignment.test", "team", "aap_team.test", "id"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckTeamResourceDestroy,
+	})
+}
+
+// testAccRoleAssignmentResource returns a configuration granting the member role of the default organization to a new team.
+func testAccRoleAssignmentResource(name string) string {
+	return fmt.Sprintf(`
+resource "aap_team" "test" {
+  name         = "%s"
+  organization = 1
+}
+
+resource "aap_role_assignment" "test" {
+  role         = "member"
+  organization = 1
+  team         = aap_team.test.id
+}`, name)
+}
+
+// testAccCheckRoleAssignmentExists queries the AAP API and verifies the role is granted.
+func testAccCheckRoleAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		assignmentResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("role assignment (%s) not found in state", name)
+		}
+
+		// Role definitions API assignments have their own URL, legacy associations are listed by the team roles
+		assignmentURL := assignmentResource.Primary.ID
+		if assignmentResource.Primary.Attributes["role_id"] != "" {
+			assignmentURL = strings.TrimSuffix(assignmentURL, assignmentResource.Primary.Attributes["role_id"])
+		}
+		assignmentResponseBody, err := testGetResource(assignmentURL)
+		if err != nil {
+			return err
+		}
+
+		if assignmentResource.Primary.Attributes["role_id"] != "" &&
+			!strings.Contains(string(assignmentResponseBody), fmt.Sprintf(`"id":%s,`, assignmentResource.Primary.Attributes["role_id"])) {
+			return fmt.Errorf("role (%s) not granted in AAP", assignmentResource.Primary.Attributes["role_id"])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,639 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Validate DTSTART time zones on systems without a time zone database
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	rruleTimeLayout = "20060102T150405"
+	rruleMaxCount   = 999
+)
+
+var (
+	// rruleFrequencies are the recurrence frequencies supported by AAP schedules.
+	rruleFrequencies = []string{"MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+	// rruleWeekdays are the days of the week used by the BYDAY and WKST rule parts.
+	rruleWeekdays = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+	// rruleNumberRanges are the allowed values of the rule parts holding lists of numbers.
+	// Zero is not allowed in the ranges accepting negative values, which count from the end of the period.
+	rruleNumberRanges = map[string][2]int{
+		"BYMONTH":    {1, 12},
+		"BYMONTHDAY": {-31, 31},
+		"BYYEARDAY":  {-366, 366},
+		"BYWEEKNO":   {-53, 53},
+		"BYHOUR":     {0, 23},
+		"BYMINUTE":   {0, 59},
+		"BYSECOND":   {0, 59},
+		"BYSETPOS":   {-366, 366},
+	}
+
+	dtstartRegex      = regexp.MustCompile(`^DTSTART(;TZID=([^:;]+))?:(\d{8}T\d{6})(Z?)$`)
+	rruleWeekdayRegex = regexp.MustCompile(`^([+-]?\d{1,2})?([A-Z]{2})$`)
+)
+
+// Schedule AAP API model
+type ScheduleAPIModel struct {
+	Id                   int64           `json:"id,omitempty"`
+	Url                  string          `json:"url,omitempty"`
+	Name                 string          `json:"name"`
+	Description          string          `json:"description"`
+	UnifiedJobTemplate   int64           `json:"unified_job_template"`
+	Rrule                string          `json:"rrule"`
+	Enabled              bool            `json:"enabled"`
+	ExtraData            json.RawMessage `json:"extra_data"`
+	Inventory            *int64          `json:"inventory"`
+	ExecutionEnvironment *int64          `json:"execution_environment"`
+	ScmBranch            *string         `json:"scm_branch"`
+	JobType              *string         `json:"job_type"`
+	JobTags              *string         `json:"job_tags"`
+	SkipTags             *string         `json:"skip_tags"`
+	Limit                *string         `json:"limit"`
+	DiffMode             *bool           `json:"diff_mode"`
+	Verbosity            *int64          `json:"verbosity"`
+	Forks                *int64          `json:"forks"`
+	JobSliceCount        *int64          `json:"job_slice_count"`
+	Timeout              *int64          `json:"timeout"`
+	NextRun              string          `json:"next_run,omitempty"`
+}
+
+// ScheduleResourceModel maps the schedule resource schema to a Go struct.
+type ScheduleResourceModel struct {
+	Id                   types.Int64                      `tfsdk:"id"`
+	Url                  types.String                     `tfsdk:"url"`
+	Name                 types.String                     `tfsdk:"name"`
+	Description          types.String                     `tfsdk:"description"`
+	UnifiedJobTemplate   types.Int64                      `tfsdk:"unified_job_template"`
+	Rrule                types.String                     `tfsdk:"rrule"`
+	Enabled              types.Bool                       `tfsdk:"enabled"`
+	ExtraData            customtypes.AAPCustomStringValue `tfsdk:"extra_data"`
+	Inventory            types.Int64                      `tfsdk:"inventory"`
+	ExecutionEnvironment types.Int64                      `tfsdk:"execution_environment"`
+	ScmBranch            types.String                     `tfsdk:"scm_branch"`
+	JobType              types.String                     `tfsdk:"job_type"`
+	JobTags              types.String                     `tfsdk:"job_tags"`
+	SkipTags             types.String                     `tfsdk:"skip_tags"`
+	Limit                types.String                     `tfsdk:"limit"`
+	DiffMode             types.Bool                       `tfsdk:"diff_mode"`
+	Verbosity            types.Int64                      `tfsdk:"verbosity"`
+	Forks                types.Int64                      `tfsdk:"forks"`
+	JobSliceCount        types.Int64                      `tfsdk:"job_slice_count"`
+	Timeout              types.Int64                      `tfsdk:"timeout"`
+	NextRun              types.String                     `tfsdk:"next_run"`
+}
+
+// ScheduleResource is the resource implementation.
+type ScheduleResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &ScheduleResource{}
+	_ resource.ResourceWithConfigure = &ScheduleResource{}
+)
+
+// NewScheduleResource is a helper function to simplify the provider implementation.
+func NewScheduleResource() resource.Resource {
+	return &ScheduleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *ScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the schedule resource.
+func (r *ScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Schedule id",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the schedule",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the schedule",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the schedule",
+			},
+			"unified_job_template": schema.Int64Attribute{
+				Required: true,
+				Description: "Identifier for the template launched by the schedule: a job template, a workflow job template, " +
+					"a project or an inventory source.",
+			},
+			"rrule": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{rruleValidator{}},
+				Description: "Recurrence of the schedule, made of a DTSTART followed by one or more RRULE and EXRULE, " +
+					"such as `DTSTART;TZID=America/New_York:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Launch jobs from the schedule. Defaults to true.",
+			},
+			"extra_data": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.AAPCustomStringType{},
+				Description: "Extra variables of the launched jobs. Must be provided as either a JSON or YAML string.",
+			},
+			"inventory": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the inventory prompted when launching the jobs.",
+			},
+			"execution_environment": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Identifier for the execution environment prompted when launching the jobs.",
+			},
+			"scm_branch": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source control branch prompted when launching the jobs.",
+			},
+			"job_type": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("run", "check")},
+				Description: "Job type prompted when launching the jobs, either run or check.",
+			},
+			"job_tags": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comma separated playbook tags prompted when launching the jobs.",
+			},
+			"skip_tags": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comma separated playbook tags to skip prompted when launching the jobs.",
+			},
+			"limit": schema.StringAttribute{
+				Optional:    true,
+				Description: "Host pattern prompted when launching the jobs.",
+			},
+			"diff_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Diff mode prompted when launching the jobs.",
+			},
+			"verbosity": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(0, maxJobVerbosity)},
+				Description: "Verbosity prompted when launching the jobs, from 0 (normal) to 5 (WinRM debug).",
+			},
+			"forks": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Number of forks prompted when launching the jobs.",
+			},
+			"job_slice_count": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+				Description: "Number of job slices prompted when launching the jobs.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Timeout in seconds prompted when launching the jobs.",
+			},
+			"next_run": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time of the next job launched by the schedule.",
+			},
+		},
+	}
+}
+
+// Create creates the schedule resource and sets the Terraform state on success.
+func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScheduleResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into schedule resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from schedule data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new schedule in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/schedules/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new schedule data into schedule resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest schedule data.
+func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScheduleResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into schedule resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest schedule data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest schedule data into schedule resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the schedule resource and sets the updated Terraform state on success.
+func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScheduleResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into schedule resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from schedule data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update schedule in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated schedule data into schedule resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the schedule resource.
+func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScheduleResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into schedule resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete schedule from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the schedule resource data
+func (r *ScheduleResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert schedule resource data to API data model
+	schedule := ScheduleAPIModel{
+		Name:                 r.Name.ValueString(),
+		Description:          r.Description.ValueString(),
+		UnifiedJobTemplate:   r.UnifiedJobTemplate.ValueInt64(),
+		Rrule:                r.Rrule.ValueString(),
+		Enabled:              r.Enabled.ValueBool(),
+		ExtraData:            json.RawMessage(`{}`),
+		Inventory:            r.Inventory.ValueInt64Pointer(),
+		ExecutionEnvironment: r.ExecutionEnvironment.ValueInt64Pointer(),
+		ScmBranch:            r.ScmBranch.ValueStringPointer(),
+		JobType:              r.JobType.ValueStringPointer(),
+		JobTags:              r.JobTags.ValueStringPointer(),
+		SkipTags:             r.SkipTags.ValueStringPointer(),
+		Limit:                r.Limit.ValueStringPointer(),
+		DiffMode:             r.DiffMode.ValueBoolPointer(),
+		Verbosity:            r.Verbosity.ValueInt64Pointer(),
+		Forks:                r.Forks.ValueInt64Pointer(),
+		JobSliceCount:        r.JobSliceCount.ValueInt64Pointer(),
+		Timeout:              r.Timeout.ValueInt64Pointer(),
+	}
+
+	if IsValueProvided(r.ExtraData) {
+		extraData, err := decodeJSONOrYAML(r.ExtraData.ValueString())
+		if err == nil {
+			schedule.ExtraData, err = json.Marshal(extraData)
+		}
+		if err != nil {
+			diags.AddError(
+				"Error marshaling request body",
+				fmt.Sprintf("Could not decode the extra data of schedule resource, unexpected error: %s", err.Error()),
+			)
+			return nil, diags
+		}
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(schedule)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for schedule resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the schedule resource data from an AAP API response
+func (r *ScheduleResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiSchedule ScheduleAPIModel
+	err := json.Unmarshal(body, &apiSchedule)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the schedule resource schema and update attribute values
+	r.Id = types.Int64Value(apiSchedule.Id)
+	r.Url = types.StringValue(apiSchedule.Url)
+	r.Name = types.StringValue(apiSchedule.Name)
+	r.Description = ParseStringValue(apiSchedule.Description)
+	r.UnifiedJobTemplate = types.Int64Value(apiSchedule.UnifiedJobTemplate)
+	r.Rrule = types.StringValue(apiSchedule.Rrule)
+	r.Enabled = types.BoolValue(apiSchedule.Enabled)
+	r.ExtraData = parseJSONObjectValue(r.ExtraData, apiSchedule.ExtraData)
+	r.Inventory = types.Int64PointerValue(apiSchedule.Inventory)
+	r.ExecutionEnvironment = types.Int64PointerValue(apiSchedule.ExecutionEnvironment)
+	r.ScmBranch = ParseStringPointerValue(apiSchedule.ScmBranch)
+	r.JobType = ParseStringPointerValue(apiSchedule.JobType)
+	r.JobTags = ParseStringPointerValue(apiSchedule.JobTags)
+	r.SkipTags = ParseStringPointerValue(apiSchedule.SkipTags)
+	r.Limit = ParseStringPointerValue(apiSchedule.Limit)
+	r.DiffMode = types.BoolPointerValue(apiSchedule.DiffMode)
+	r.Verbosity = types.Int64PointerValue(apiSchedule.Verbosity)
+	r.Forks = types.Int64PointerValue(apiSchedule.Forks)
+	r.JobSliceCount = types.Int64PointerValue(apiSchedule.JobSliceCount)
+	r.Timeout = types.Int64PointerValue(apiSchedule.Timeout)
+	r.NextRun = ParseStringValue(apiSchedule.NextRun)
+
+	return diags
+}
+
+// rruleValidator validates the recurrence of a schedule at plan time.
+type rruleValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v rruleValidator) Description(_ context.Context) string {
+	return "value must be a DTSTART followed by one or more RRULE and EXRULE"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v rruleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v rruleValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	err := validateRRule(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schedule recurrence", err.Error())
+	}
+}
+
+// validateRRule checks the syntax of a schedule recurrence, such as
+// DTSTART;TZID=America/New_York:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1.
+func validateRRule(value string) error {
+	parts := strings.Fields(value)
+	if len(parts) == 0 {
+		return errors.New("the recurrence is empty")
+	}
+
+	match := dtstartRegex.FindStringSubmatch(parts[0])
+	if match == nil {
+		return fmt.Errorf("the recurrence must start with a DTSTART such as DTSTART;TZID=UTC:20240101T030000, got %q", parts[0])
+	}
+	if match[2] != "" && match[4] != "" {
+		return errors.New("the DTSTART can not have both a TZID and a UTC time")
+	}
+	if match[2] == "" && match[4] == "" {
+		return errors.New("the DTSTART must have either a TZID or a UTC time ending with Z")
+	}
+	if match[2] != "" {
+		if _, err := time.LoadLocation(match[2]); err != nil {
+			return fmt.Errorf("unknown DTSTART time zone %q", match[2])
+		}
+	}
+	if _, err := time.Parse(rruleTimeLayout, match[3]); err != nil {
+		return fmt.Errorf("invalid DTSTART date %q", match[3])
+	}
+
+	rules := 0
+	for _, part := range parts[1:] {
+		name, rule, _ := strings.Cut(part, ":")
+		if name != "RRULE" && name != "EXRULE" {
+			return fmt.Errorf("unexpected %q, expected an RRULE or an EXRULE", part)
+		}
+		if err := validateRRuleParts(rule); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+		if name == "RRULE" {
+			rules++
+		}
+	}
+	if rules == 0 {
+		return errors.New("the recurrence must contain at least one RRULE")
+	}
+
+	return nil
+}
+
+// validateRRuleParts checks the KEY=VALUE parts of a single RRULE or EXRULE.
+func validateRRuleParts(rule string) error {
+	parts := map[string]string{}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		if value == "" {
+			return fmt.Errorf("%q is not a KEY=VALUE pair", part)
+		}
+		if _, ok := parts[key]; ok {
+			return fmt.Errorf("%s is set more than once", key)
+		}
+		parts[key] = value
+	}
+
+	if !slices.Contains(rruleFrequencies, parts["FREQ"]) {
+		return fmt.Errorf("FREQ must be one of %s", strings.Join(rruleFrequencies, ", "))
+	}
+	if parts["COUNT"] != "" && parts["UNTIL"] != "" {
+		return errors.New("COUNT and UNTIL can not be used together")
+	}
+
+	keys := make([]string, 0, len(parts))
+	for key := range parts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := validateRRulePart(key, parts[key]); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRRulePart checks the value of a single rule part.
+func validateRRulePart(key string, value string) error {
+	if numberRange, ok := rruleNumberRanges[key]; ok {
+		for _, number := range strings.Split(value, ",") {
+			if err := validateRRuleNumber(number, numberRange[0], numberRange[1]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch key {
+	case "FREQ":
+		return nil
+	case "INTERVAL":
+		return validateRRuleNumber(value, 1, int(^uint32(0)>>1))
+	case "COUNT":
+		return validateRRuleNumber(value, 1, rruleMaxCount)
+	case "UNTIL":
+		if _, err := time.Parse(rruleTimeLayout, strings.TrimSuffix(value, "Z")); err != nil {
+			return fmt.Errorf("invalid date %q", value)
+		}
+		return nil
+	case "WKST":
+		if !slices.Contains(rruleWeekdays, value) {
+			return fmt.Errorf("invalid day %q", value)
+		}
+		return nil
+	case "BYDAY":
+		for _, day := range strings.Split(value, ",") {
+			match := rruleWeekdayRegex.FindStringSubmatch(day)
+			if match == nil || !slices.Contains(rruleWeekdays, match[2]) {
+				return fmt.Errorf("invalid day %q", day)
+			}
+		}
+		return nil
+	default:
+		return errors.New("unsupported rule part")
+	}
+}
+
+// validateRRuleNumber checks that a rule part number is within the provided range.
+// Zero is not allowed when the range accepts negative numbers.
+func validateRRuleNumber(value string, minimum int, maximum int) error {
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%q is not a number", value)
+	}
+	if number < minimum || number > maximum || (minimum < 0 && number == 0) {
+		return fmt.Errorf("%d is out of range", number)
+	}
+	return nil
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestScheduleResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the ScheduleResource and call its Schema method
+	NewScheduleResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// newTestScheduleResourceModel returns a schedule resource model with only the required values set.
+func newTestScheduleResourceModel() ScheduleResourceModel {
+	return ScheduleResourceModel{
+		Name:                 types.StringValue("test schedule"),
+		Description:          types.StringNull(),
+		UnifiedJobTemplate:   types.Int64Value(7),
+		Rrule:                types.StringValue("DTSTART;TZID=UTC:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1"),
+		Enabled:              types.BoolValue(true),
+		ExtraData:            customtypes.NewAAPCustomStringNull(),
+		Inventory:            types.Int64Null(),
+		ExecutionEnvironment: types.Int64Null(),
+		ScmBranch:            types.StringNull(),
+		JobType:              types.StringNull(),
+		JobTags:              types.StringNull(),
+		SkipTags:             types.StringNull(),
+		Limit:                types.StringNull(),
+		DiffMode:             types.BoolNull(),
+		Verbosity:            types.Int64Null(),
+		Forks:                types.Int64Null(),
+		JobSliceCount:        types.Int64Null(),
+		Timeout:              types.Int64Null(),
+		NextRun:              types.StringNull(),
+	}
+}
+
+func TestScheduleResourceCreateRequestBody(t *testing.T) {
+	prompted := newTestScheduleResourceModel()
+	prompted.Enabled = types.BoolValue(false)
+	prompted.ExtraData = customtypes.NewAAPCustomStringValue("foo: bar")
+	prompted.Inventory = types.Int64Value(2)
+	prompted.JobType = types.StringValue("check")
+	prompted.Limit = types.StringValue("web")
+	prompted.DiffMode = types.BoolValue(true)
+	prompted.Verbosity = types.Int64Value(3)
+
+	var testTable = []struct {
+		name     string
+		input    ScheduleResourceModel
+		expected []byte
+	}{
+		{
+			name:  "required values",
+			input: newTestScheduleResourceModel(),
+			expected: []byte(`{"name":"test schedule","description":"","unified_job_template":7,` +
+				`"rrule":"DTSTART;TZID=UTC:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1","enabled":true,"extra_data":{},` +
+				`"inventory":null,"execution_environment":null,"scm_branch":null,"job_type":null,"job_tags":null,` +
+				`"skip_tags":null,"limit":null,"diff_mode":null,"verbosity":null,"forks":null,"job_slice_count":null,"timeout":null}`),
+		},
+		{
+			name:  "prompted values",
+			input: prompted,
+			expected: []byte(`{"name":"test schedule","description":"","unified_job_template":7,` +
+				`"rrule":"DTSTART;TZID=UTC:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1","enabled":false,"extra_data":{"foo":"bar"},` +
+				`"inventory":2,"execution_environment":null,"scm_branch":null,"job_type":"check","job_tags":null,` +
+				`"skip_tags":null,"limit":"web","diff_mode":true,"verbosity":3,"forks":null,"job_slice_count":null,"timeout":null}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestScheduleResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	required := newTestScheduleResourceModel()
+	required.Id = types.Int64Value(3)
+	required.Url = types.StringValue("/api/v2/schedules/3/")
+	required.NextRun = types.StringValue("2024-01-02T03:00:00Z")
+
+	prompted := required
+	prompted.Enabled = types.BoolValue(false)
+	prompted.ExtraData = customtypes.NewAAPCustomStringValue(`{"foo":"bar"}`)
+	prompted.Inventory = types.Int64Value(2)
+	prompted.JobType = types.StringValue("check")
+	prompted.DiffMode = types.BoolValue(true)
+	prompted.NextRun = types.StringNull()
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected ScheduleResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: ScheduleResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "required values",
+			input: []byte(`{"id":3,"url":"/api/v2/schedules/3/","name":"test schedule","description":"",` +
+				`"unified_job_template":7,"rrule":"DTSTART;TZID=UTC:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1",` +
+				`"enabled":true,"extra_data":{},"inventory":null,"execution_environment":null,"scm_branch":null,` +
+				`"job_type":null,"job_tags":null,"skip_tags":null,"limit":null,"diff_mode":null,"verbosity":null,` +
+				`"forks":null,"job_slice_count":null,"timeout":null,"next_run":"2024-01-02T03:00:00Z"}`),
+			expected: required,
+			errors:   diag.Diagnostics{},
+		},
+		{
+			name: "prompted values",
+			input: []byte(`{"id":3,"url":"/api/v2/schedules/3/","name":"test schedule","description":"",` +
+				`"unified_job_template":7,"rrule":"DTSTART;TZID=UTC:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1",` +
+				`"enabled":false,"extra_data":{"foo":"bar"},"inventory":2,"execution_environment":null,"scm_branch":"",` +
+				`"job_type":"check","job_tags":"","skip_tags":"","limit":"","diff_mode":true,"verbosity":null,` +
+				`"forks":null,"job_slice_count":null,"timeout":null,"next_run":null}`),
+			expected: prompted,
+			errors:   diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := ScheduleResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestValidateRRule(t *testing.T) {
+	t.Parallel()
+
+	var testTable = []struct {
+		rrule    string
+		expected string
+	}{
+		{"DTSTART;TZID=America/New_York:20240101T030000 RRULE:FREQ=DAILY;INTERVAL=1", ""},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=10", ""},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20241231T000000Z", ""},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=MONTHLY;BYMONTHDAY=-1 EXRULE:FREQ=YEARLY;BYMONTH=12", ""},
+		{"DTSTART;TZID=UTC:20240101T030000 RRULE:FREQ=HOURLY;BYHOUR=8,12,16;BYMINUTE=30;WKST=SU", ""},
+		{"", "the recurrence is empty"},
+		{"RRULE:FREQ=DAILY", "the recurrence must start with a DTSTART"},
+		{"DTSTART:20240101 RRULE:FREQ=DAILY", "the recurrence must start with a DTSTART"},
+		{"DTSTART:20240101T030000 RRULE:FREQ=DAILY", "either a TZID or a UTC time"},
+		{"DTSTART;TZID=UTC:20240101T030000Z RRULE:FREQ=DAILY", "both a TZID and a UTC time"},
+		{"DTSTART;TZID=Mars/Olympus:20240101T030000 RRULE:FREQ=DAILY", "unknown DTSTART time zone"},
+		{"DTSTART:20241301T030000Z RRULE:FREQ=DAILY", "invalid DTSTART date"},
+		{"DTSTART:20240101T030000Z", "at least one RRULE"},
+		{"DTSTART:20240101T030000Z EXRULE:FREQ=DAILY", "at least one RRULE"},
+		{"DTSTART:20240101T030000Z RDATE:20240102T030000Z", "expected an RRULE or an EXRULE"},
+		{"DTSTART:20240101T030000Z RRULE:INTERVAL=1", "FREQ must be one of"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=FORTNIGHTLY", "FREQ must be one of"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;INTERVAL", "is not a KEY=VALUE pair"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;FREQ=WEEKLY", "FREQ is set more than once"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;INTERVAL=0", "INTERVAL: 0 is out of range"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;COUNT=many", "COUNT: \"many\" is not a number"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;COUNT=5;UNTIL=20241231T000000Z", "COUNT and UNTIL"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;UNTIL=2024", "UNTIL: invalid date"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=WEEKLY;BYDAY=MO,XX", "BYDAY: invalid day \"XX\""},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=MONTHLY;BYMONTHDAY=0", "BYMONTHDAY: 0 is out of range"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=YEARLY;BYMONTH=13", "BYMONTH: 13 is out of range"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;BYHOUR=24", "BYHOUR: 24 is out of range"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;WKST=SUN", "WKST: invalid day"},
+		{"DTSTART:20240101T030000Z RRULE:FREQ=DAILY;BYEASTER=1", "BYEASTER: unsupported rule part"},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.rrule, func(t *testing.T) {
+			err := validateRRule(test.rrule)
+			if test.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error for (%s), got (%s)", test.rrule, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("Expected error containing (%s) for (%s), got (%v)", test.expected, test.rrule, err)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccScheduleResource(t *testing.T) {
+	var schedule ScheduleAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid recurrence is rejected at plan time
+			{
+				Config:      testAccScheduleResource(randomName, jobTemplateID, "RRULE:FREQ=DAILY", true),
+				ExpectError: regexp.MustCompile("Invalid schedule recurrence"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccScheduleResource(randomName, jobTemplateID, "RRULE:FREQ=DAILY;INTERVAL=1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduleResourceExists("aap_schedule.test", &schedule),
+					resource.TestCheckResourceAttr("aap_schedule.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_schedule.test", "unified_job_template", jobTemplateID),
+					resource.TestCheckResourceAttr("aap_schedule.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("aap_schedule.test", "next_run"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccScheduleResource(randomName, jobTemplateID, "RRULE:FREQ=WEEKLY;BYDAY=MO,FR", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduleResourceExists("aap_schedule.test", &schedule),
+					resource.TestCheckResourceAttr("aap_schedule.test", "enabled", "false"),
+					resource.TestMatchResourceAttr("aap_schedule.test", "rrule", regexp.MustCompile("BYDAY=MO,FR")),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckScheduleResourceDestroy,
+	})
+}
+
+// testAccScheduleResource returns a configuration for an AAP Schedule with the provided recurrence rule.
+func testAccScheduleResource(name string, jobTemplateID string, rule string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aap_schedule" "test" {
+  name                 = "%s"
+  unified_job_template = %s
+  rrule                = "DTSTART;TZID=UTC:20300101T030000 %s"
+  enabled              = %t
+}`, name, jobTemplateID, rule, enabled)
+}
+
+// testAccCheckScheduleResourceExists queries the AAP API and retrieves the matching schedule.
+func testAccCheckScheduleResourceExists(name string, schedule *ScheduleAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		scheduleResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("schedule (%s) not found in state", name)
+		}
+
+		scheduleResponseBody, err := testGetResource(scheduleResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(scheduleResponseBody, &schedule)
+		if err != nil {
+			return err
+		}
+
+		if schedule.Id == 0 {
+			return fmt.Errorf("schedule (%s) not found in AAP", scheduleResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckScheduleResourceDestroy verifies the schedule has been destroyed.
+func testAccCheckScheduleResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_schedule" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("schedule (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
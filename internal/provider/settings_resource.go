@@ -0,0 +1,446 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SettingsResourceModel maps the settings resource schema to a Go struct.
+type SettingsResourceModel struct {
+	Id               types.String                     `tfsdk:"id"`
+	Category         types.String                     `tfsdk:"category"`
+	Settings         customtypes.AAPCustomStringValue `tfsdk:"settings"`
+	PreviousSettings types.String                     `tfsdk:"previous_settings"`
+}
+
+// SettingsResource is the resource implementation.
+type SettingsResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &SettingsResource{}
+	_ resource.ResourceWithConfigure = &SettingsResource{}
+)
+
+// NewSettingsResource is a helper function to simplify the provider implementation.
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *SettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_settings"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the settings resource.
+func (r *SettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier of the settings, made of the URL of the settings category.",
+			},
+			"category": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+				Description: "Category of the settings, such as jobs, system, ldap or saml.",
+			},
+			"settings": schema.StringAttribute{
+				Required:   true,
+				Sensitive:  true,
+				CustomType: customtypes.AAPCustomStringType{},
+				Description: "Settings of the category managed by Terraform, the other settings of the category are left " +
+					"untouched. Must be provided as either a JSON or YAML object. AAP never returns the value of secret " +
+					"settings, so changes made to them outside of Terraform are not detected.",
+			},
+			"previous_settings": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "JSON object of the values the managed settings had before Terraform managed them, restored " +
+					"when the settings are no longer managed. AAP never returns the value of secret settings, so secret " +
+					"settings keep their value.",
+			},
+		},
+	}
+}
+
+// Create updates the managed settings of the category and sets the Terraform state on success.
+func (r *SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SettingsResourceModel
+
+	// Read Terraform plan data into settings resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(data.SettingsURL())
+
+	// Save the current values of the managed settings, to restore them later
+	resp.Diagnostics.Append(r.SavePreviousSettings(&data, SettingsResourceModel{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update managed settings in AAP
+	resp.Diagnostics.Append(r.PatchSettings(&data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest values of the managed settings.
+func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SettingsResourceModel
+
+	// Read current Terraform state data into settings resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest settings data from AAP
+	readResponse, readResponseBody, err := r.client.doRequest(http.MethodGet, data.SettingsURL(), nil)
+	if readResponse != nil && readResponse.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(ValidateResponse(readResponse, readResponseBody, err, []int{http.StatusOK})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest settings data into settings resource model
+	resp.Diagnostics.Append(data.ParseHttpResponse(readResponseBody)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the managed settings of the category, restoring the previous values of the settings which are no
+// longer managed, and sets the updated Terraform state on success.
+func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SettingsResourceModel
+
+	// Read Terraform plan and state data into settings resource models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = state.Id
+
+	// Save the current values of the newly managed settings, and find the settings to restore
+	resp.Diagnostics.Append(r.SavePreviousSettings(&data, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	restored, diags := data.RestoredSettings(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update managed settings in AAP
+	resp.Diagnostics.Append(r.PatchSettings(&data, restored)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete restores the previous values of the managed settings.
+func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SettingsResourceModel
+
+	// Read current Terraform state data into settings resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := decodeSettings(data.PreviousSettings.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Restore previous settings in AAP, secret settings keep their value
+	for key, value := range previous {
+		if isEncryptedSetting(value) {
+			delete(previous, key)
+		}
+	}
+	resp.Diagnostics.Append(r.patchCategory(data.SettingsURL(), previous)...)
+}
+
+// SavePreviousSettings saves into the settings resource model the values the managed settings had before Terraform
+// managed them. The previous values of the settings already managed in the provided state are kept, the ones of the
+// newly managed settings are read from AAP.
+func (r *SettingsResource) SavePreviousSettings(data *SettingsResourceModel, state SettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured, diagsDecode := decodeSettings(data.Settings.ValueString())
+	diags.Append(diagsDecode...)
+	statePrevious, diagsDecode := decodeSettings(state.PreviousSettings.ValueString())
+	diags.Append(diagsDecode...)
+	if diags.HasError() {
+		return diags
+	}
+
+	readResponseBody, diagsGet := r.client.Get(data.SettingsURL())
+	diags.Append(diagsGet...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var current map[string]json.RawMessage
+	err := json.Unmarshal(readResponseBody, &current)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	previous := make(map[string]json.RawMessage, len(configured))
+	for key := range configured {
+		if value, found := statePrevious[key]; found {
+			previous[key] = value
+		} else if value, found := current[key]; found {
+			previous[key] = value
+		} else {
+			diags.AddAttributeError(
+				path.Root("settings"),
+				"Unknown setting",
+				fmt.Sprintf("The setting %q does not exist in the %s settings category", key, data.Category.ValueString()),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	jsonPrevious, _ := json.Marshal(previous)
+	data.PreviousSettings = types.StringValue(string(jsonPrevious))
+	return diags
+}
+
+// PatchSettings updates the managed settings, along with the provided restored settings, and saves the resulting
+// settings into the settings resource model.
+func (r *SettingsResource) PatchSettings(data *SettingsResourceModel, restored map[string]json.RawMessage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	requestBody, diagsBody := data.CreateRequestBody(restored)
+	diags.Append(diagsBody...)
+	if diags.HasError() {
+		return diags
+	}
+
+	updateResponse, updateResponseBody, err := r.client.doRequest(http.MethodPatch, data.SettingsURL(), bytes.NewReader(requestBody))
+	diags.Append(ValidateResponse(updateResponse, updateResponseBody, err, []int{http.StatusOK})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.ParseHttpResponse(updateResponseBody)...)
+	return diags
+}
+
+// patchCategory updates the provided settings of the category at the provided URL.
+func (r *SettingsResource) patchCategory(url string, settings map[string]json.RawMessage) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(settings) == 0 {
+		return diags
+	}
+
+	requestBody, err := json.Marshal(settings)
+	if err != nil {
+		diags.AddError("Body JSON Marshal Error", err.Error())
+		return diags
+	}
+
+	updateResponse, updateResponseBody, err := r.client.doRequest(http.MethodPatch, url, bytes.NewReader(requestBody))
+	diags.Append(ValidateResponse(updateResponse, updateResponseBody, err, []int{http.StatusOK})...)
+	return diags
+}
+
+// SettingsURL returns the URL of the settings category.
+func (r *SettingsResourceModel) SettingsURL() string {
+	return fmt.Sprintf("/api/v2/settings/%s/", r.Category.ValueString())
+}
+
+// RestoredSettings returns the previous values of the settings managed in the provided state which are no longer
+// managed, excluding the secret settings whose previous values are unknown.
+func (r *SettingsResourceModel) RestoredSettings(state SettingsResourceModel) (map[string]json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configured, diagsDecode := decodeSettings(r.Settings.ValueString())
+	diags.Append(diagsDecode...)
+	statePrevious, diagsDecode := decodeSettings(state.PreviousSettings.ValueString())
+	diags.Append(diagsDecode...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	restored := map[string]json.RawMessage{}
+	for key, value := range statePrevious {
+		if _, found := configured[key]; !found && !isEncryptedSetting(value) {
+			restored[key] = value
+		}
+	}
+
+	return restored, diags
+}
+
+// CreateRequestBody creates a JSON encoded request body from the settings resource data, along with the provided
+// restored settings.
+func (r *SettingsResourceModel) CreateRequestBody(restored map[string]json.RawMessage) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configured, diagsDecode := decodeSettings(r.Settings.ValueString())
+	diags.Append(diagsDecode...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	settings := make(map[string]json.RawMessage, len(configured)+len(restored))
+	for key, value := range restored {
+		settings[key] = value
+	}
+	for key, value := range configured {
+		settings[key] = value
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(settings)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for settings resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the managed settings from an AAP API response. Secret settings returned as $encrypted$
+// keep their configured value, and the settings which are not managed are ignored.
+func (r *SettingsResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiSettings map[string]json.RawMessage
+	err := json.Unmarshal(body, &apiSettings)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	configured, diagsDecode := decodeSettings(r.Settings.ValueString())
+	diags.Append(diagsDecode...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Map response to the settings resource schema and update attribute values
+	settings := make(map[string]json.RawMessage, len(configured))
+	for key, value := range configured {
+		apiValue, found := apiSettings[key]
+		if !found {
+			continue
+		}
+		if isEncryptedSetting(apiValue) {
+			apiValue = value
+		}
+		settings[key] = apiValue
+	}
+	jsonSettings, _ := json.Marshal(settings)
+	r.Settings = parseJSONObjectValue(r.Settings, jsonSettings)
+
+	return diags
+}
+
+// decodeSettings decodes the settings provided as a JSON or YAML object. An empty value is decoded as no settings.
+func decodeSettings(value string) (map[string]json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	decoded, err := decodeJSONOrYAML(value)
+	object, ok := decoded.(map[string]interface{})
+	if err != nil || !ok {
+		detail := "The settings must be a JSON or YAML object"
+		if err != nil {
+			detail = fmt.Sprintf("%s, unexpected error: %s", detail, err.Error())
+		}
+		diags.AddAttributeError(path.Root("settings"), "Invalid settings", detail)
+		return nil, diags
+	}
+
+	settings := make(map[string]json.RawMessage, len(object))
+	for key, value := range object {
+		settings[key], _ = json.Marshal(value)
+	}
+
+	return settings, diags
+}
+
+// isEncryptedSetting returns true for the values of secret settings, which AAP returns as $encrypted$.
+func isEncryptedSetting(value json.RawMessage) bool {
+	var stringValue string
+	return json.Unmarshal(value, &stringValue) == nil && stringValue == encryptedValue
+}
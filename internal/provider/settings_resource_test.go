@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestSettingsResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the SettingsResource and call its Schema method
+	NewSettingsResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestSettingsResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    SettingsResourceModel
+		restored map[string]json.RawMessage
+		expected []byte
+	}{
+		{
+			name: "JSON settings",
+			input: SettingsResourceModel{
+				Settings: customtypes.NewAAPCustomStringValue(`{"DEFAULT_JOB_TIMEOUT": 3600}`),
+			},
+			expected: []byte(`{"DEFAULT_JOB_TIMEOUT":3600}`),
+		},
+		{
+			name: "YAML settings with restored settings",
+			input: SettingsResourceModel{
+				Settings: customtypes.NewAAPCustomStringValue("DEFAULT_JOB_TIMEOUT: 3600\nAWX_TASK_ENV:\n  HTTPS_PROXY: proxy:3128\n"),
+			},
+			restored: map[string]json.RawMessage{"DEFAULT_PROJECT_UPDATE_TIMEOUT": json.RawMessage("0")},
+			expected: []byte(`{"DEFAULT_JOB_TIMEOUT":3600,"AWX_TASK_ENV":{"HTTPS_PROXY":"proxy:3128"},` +
+				`"DEFAULT_PROJECT_UPDATE_TIMEOUT":0}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody(test.restored)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSettingsResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		current  customtypes.AAPCustomStringValue
+		input    []byte
+		expected customtypes.AAPCustomStringValue
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			current:  customtypes.NewAAPCustomStringValue(`{"DEFAULT_JOB_TIMEOUT": 3600}`),
+			input:    []byte("Not valid JSON"),
+			expected: customtypes.NewAAPCustomStringValue(`{"DEFAULT_JOB_TIMEOUT": 3600}`),
+			errors:   jsonError,
+		},
+		{
+			name:     "unmanaged settings are ignored",
+			current:  customtypes.NewAAPCustomStringValue("DEFAULT_JOB_TIMEOUT: 3600"),
+			input:    []byte(`{"DEFAULT_JOB_TIMEOUT":3600,"DEFAULT_PROJECT_UPDATE_TIMEOUT":0}`),
+			expected: customtypes.NewAAPCustomStringValue("DEFAULT_JOB_TIMEOUT: 3600"),
+			errors:   diag.Diagnostics{},
+		},
+		{
+			name:     "encrypted secret keeps configured value",
+			current:  customtypes.NewAAPCustomStringValue(`{"AUTH_LDAP_BIND_PASSWORD":"secret","AUTH_LDAP_BIND_DN":"cn=aap"}`),
+			input:    []byte(`{"AUTH_LDAP_BIND_PASSWORD":"$encrypted$","AUTH_LDAP_BIND_DN":"cn=admin"}`),
+			expected: customtypes.NewAAPCustomStringValue(`{"AUTH_LDAP_BIND_DN":"cn=admin","AUTH_LDAP_BIND_PASSWORD":"secret"}`),
+			errors:   diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := SettingsResourceModel{Settings: test.current}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !test.expected.Equal(resource.Settings) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource.Settings)
+			}
+		})
+	}
+}
+
+func TestSettingsResourceRestoredSettings(t *testing.T) {
+	state := SettingsResourceModel{
+		PreviousSettings: types.StringValue(
+			`{"DEFAULT_JOB_TIMEOUT":0,"DEFAULT_PROJECT_UPDATE_TIMEOUT":0,"AUTH_LDAP_BIND_PASSWORD":"$encrypted$"}`),
+	}
+	data := SettingsResourceModel{
+		Settings: customtypes.NewAAPCustomStringValue(`{"DEFAULT_JOB_TIMEOUT": 3600}`),
+	}
+
+	actual, diags := data.RestoredSettings(state)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	expected := map[string]json.RawMessage{"DEFAULT_PROJECT_UPDATE_TIMEOUT": json.RawMessage("0")}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected (%s) not equal to actual (%s)", expected, actual)
+	}
+}
+
+func TestDecodeSettings(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    string
+		expected map[string]json.RawMessage
+		errors   []string
+	}{
+		{
+			name:     "empty settings",
+			input:    "",
+			expected: map[string]json.RawMessage{},
+		},
+		{
+			name:     "YAML settings",
+			input:    "DEFAULT_JOB_TIMEOUT: 3600",
+			expected: map[string]json.RawMessage{"DEFAULT_JOB_TIMEOUT": json.RawMessage("3600")},
+		},
+		{
+			name:   "not an object",
+			input:  `["DEFAULT_JOB_TIMEOUT"]`,
+			errors: []string{"Invalid settings"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := decodeSettings(test.input)
+			var errors []string
+			for _, err := range diags.Errors() {
+				errors = append(errors, err.Summary())
+			}
+			if !reflect.DeepEqual(test.errors, errors) {
+				t.Errorf("Expected errors (%v) not equal to actual (%v)", test.errors, errors)
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccSettingsResource(t *testing.T) {
+	var previousTimeout json.RawMessage
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					value, err := testAccGetSetting("jobs", "DEFAULT_JOB_TIMEOUT")
+					if err != nil {
+						t.Fatal(err)
+					}
+					previousTimeout = value
+				},
+				Config: testAccSettingsResource(3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSetting("jobs", "DEFAULT_JOB_TIMEOUT", json.RawMessage("3600")),
+					resource.TestCheckResourceAttr("aap_settings.test", "id", "/api/v2/settings/jobs/"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSettingsResource(7200),
+				Check:  testAccCheckSetting("jobs", "DEFAULT_JOB_TIMEOUT", json.RawMessage("7200")),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			return testAccCheckSetting("jobs", "DEFAULT_JOB_TIMEOUT", previousTimeout)(nil)
+		},
+	})
+}
+
+// testAccSettingsResource returns a configuration for AAP Settings setting the default job timeout.
+func testAccSettingsResource(timeout int) string {
+	return fmt.Sprintf(`
+resource "aap_settings" "test" {
+  category = "jobs"
+  settings = jsonencode({
+    DEFAULT_JOB_TIMEOUT = %d
+  })
+}`, timeout)
+}
+
+// testAccGetSetting queries the AAP API and retrieves the value of the setting of the category.
+func testAccGetSetting(category string, key string) (json.RawMessage, error) {
+	settingsResponseBody, err := testGetResource(fmt.Sprintf("/api/v2/settings/%s/", category))
+	if err != nil {
+		return nil, err
+	}
+
+	var settings map[string]json.RawMessage
+	err = json.Unmarshal(settingsResponseBody, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings[key], nil
+}
+
+// testAccCheckSetting queries the AAP API and checks the value of the setting of the category.
+func testAccCheckSetting(category string, key string, expected json.RawMessage) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		actual, err := testAccGetSetting(category, key)
+		if err != nil {
+			return err
+		}
+
+		equal, err := DeepEqualJSONByte(expected, actual)
+		if err != nil {
+			return err
+		}
+		if !equal {
+			return fmt.Errorf("setting %s of category %s is %s, expected %s", key, category, actual, expected)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,725 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	// surveyTemplates are the kinds of templates a survey can be attached to.
+	surveyTemplates = []string{"job_template", "workflow_job_template"}
+	// surveyQuestionTypes are the types of answers of the survey questions.
+	surveyQuestionTypes = []string{"text", "textarea", "password", "integer", "float", "multiplechoice", "multiselect"}
+	// surveyChoiceTypes are the types of the survey questions answered from a list of choices.
+	surveyChoiceTypes = []string{"multiplechoice", "multiselect"}
+)
+
+// Survey spec AAP API model
+type SurveySpecAPIModel struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Spec        []SurveyQuestionAPIModel `json:"spec"`
+}
+
+// Survey question AAP API model
+type SurveyQuestionAPIModel struct {
+	Variable            string          `json:"variable"`
+	QuestionName        string          `json:"question_name"`
+	QuestionDescription string          `json:"question_description"`
+	Type                string          `json:"type"`
+	Required            bool            `json:"required"`
+	Choices             json.RawMessage `json:"choices,omitempty"`
+	Min                 *int64          `json:"min,omitempty"`
+	Max                 *int64          `json:"max,omitempty"`
+	Default             json.RawMessage `json:"default,omitempty"`
+}
+
+// SurveySpecResourceModel maps the survey spec resource schema to a Go struct.
+type SurveySpecResourceModel struct {
+	Id                  types.String          `tfsdk:"id"`
+	JobTemplate         types.Int64           `tfsdk:"job_template"`
+	WorkflowJobTemplate types.Int64           `tfsdk:"workflow_job_template"`
+	Name                types.String          `tfsdk:"name"`
+	Description         types.String          `tfsdk:"description"`
+	Enabled             types.Bool            `tfsdk:"enabled"`
+	Questions           []SurveyQuestionModel `tfsdk:"question"`
+}
+
+// SurveyQuestionModel maps a question of the survey spec resource schema to a Go struct.
+type SurveyQuestionModel struct {
+	Variable            types.String `tfsdk:"variable"`
+	QuestionName        types.String `tfsdk:"question_name"`
+	QuestionDescription types.String `tfsdk:"question_description"`
+	Type                types.String `tfsdk:"type"`
+	Required            types.Bool   `tfsdk:"required"`
+	Choices             types.List   `tfsdk:"choices"`
+	Min                 types.Int64  `tfsdk:"min"`
+	Max                 types.Int64  `tfsdk:"max"`
+	Default             types.String `tfsdk:"default"`
+}
+
+// SurveySpecResource is the resource implementation.
+type SurveySpecResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &SurveySpecResource{}
+	_ resource.ResourceWithConfigure      = &SurveySpecResource{}
+	_ resource.ResourceWithValidateConfig = &SurveySpecResource{}
+	_ resource.ResourceWithImportState    = &SurveySpecResource{}
+)
+
+// NewSurveySpecResource is a helper function to simplify the provider implementation.
+func NewSurveySpecResource() resource.Resource {
+	return &SurveySpecResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *SurveySpecResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_survey_spec"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SurveySpecResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the survey spec resource.
+func (r *SurveySpecResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	templateAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.ExactlyOneOf(
+					path.MatchRoot("job_template"),
+					path.MatchRoot("workflow_job_template"),
+				),
+			},
+			Description: description + " Exactly one of job_template or workflow_job_template must be provided.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier of the survey spec, made of the URL of the survey spec of the template.",
+			},
+			"job_template":          templateAttribute("Identifier for the job template the survey belongs to."),
+			"workflow_job_template": templateAttribute("Identifier for the workflow job template the survey belongs to."),
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the survey",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description for the survey",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Prompt for the survey when launching a job from the template. Defaults to true.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"question": schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				Description: "Questions of the survey, in the order they are asked.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"variable": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+							Description: "Name of the extra variable holding the answer, unique within the survey.",
+						},
+						"question_name": schema.StringAttribute{
+							Required:    true,
+							Description: "Question asked to the user",
+						},
+						"question_description": schema.StringAttribute{
+							Optional:    true,
+							Description: "Description for the question",
+						},
+						"type": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{stringvalidator.OneOf(surveyQuestionTypes...)},
+							Description: fmt.Sprintf("Type of the answer, one of %s.",
+								strings.Join(surveyQuestionTypes, ", ")),
+						},
+						"required": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Require an answer to the question.",
+						},
+						"choices": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Choices offered to the user. Required for the multiplechoice and multiselect types only.",
+						},
+						"min": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Minimum value of the integer and float answers, or minimum length of the text answers.",
+						},
+						"max": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum value of the integer and float answers, or maximum length of the text answers.",
+						},
+						"default": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Description: "Default answer. The choices selected by default are separated by newlines for the " +
+								"multiselect type. The default of the password type is write-only: AAP never returns it, so " +
+								"changes made to it outside of Terraform are not detected.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the questions have unique variables and the attributes matching their type.
+func (r *SurveySpecResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SurveySpecResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSurveyQuestions(data.Questions)...)
+}
+
+// Create writes the survey spec of the template and sets the Terraform state on success.
+func (r *SurveySpecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SurveySpecResourceModel
+
+	// Read Terraform plan data into survey spec resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write survey spec in AAP
+	resp.Diagnostics.Append(r.WriteSurveySpec(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest survey spec data, removing the survey spec when the template
+// no longer has one.
+func (r *SurveySpecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SurveySpecResourceModel
+
+	// Read current Terraform state data into survey spec resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest survey spec data from AAP
+	found, diags := r.ReadSurveySpec(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update writes the updated survey spec of the template and sets the updated Terraform state on success.
+func (r *SurveySpecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SurveySpecResourceModel
+
+	// Read Terraform plan and state data into survey spec resource models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write survey spec in AAP, keeping the unchanged password defaults stored in AAP
+	resp.Diagnostics.Append(r.WriteSurveySpec(ctx, &data, state.Questions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the survey spec of the template and disables the survey.
+func (r *SurveySpecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SurveySpecResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into survey spec resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disable the survey and delete the survey spec from AAP
+	resp.Diagnostics.Append(r.setSurveyEnabled(&data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, diags = r.client.Delete(data.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ImportState imports the survey spec of an existing template, from the kind of template and its id separated by
+// a slash, such as job_template/7.
+func (r *SurveySpecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	kind, rawID, _ := strings.Cut(req.ID, "/")
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || !slices.Contains(surveyTemplates, kind) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected job_template or workflow_job_template and the template id separated by a slash, got: %s", req.ID),
+		)
+		return
+	}
+
+	data := SurveySpecResourceModel{
+		JobTemplate:         types.Int64Null(),
+		WorkflowJobTemplate: types.Int64Null(),
+	}
+	if kind == "job_template" {
+		data.JobTemplate = types.Int64Value(id)
+	} else {
+		data.WorkflowJobTemplate = types.Int64Value(id)
+	}
+	data.Id = types.StringValue(data.SurveySpecURL())
+
+	// Get survey spec data from AAP
+	found, diags := r.ReadSurveySpec(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Survey spec not found",
+			fmt.Sprintf("The %s %d has no survey spec.", strings.ReplaceAll(kind, "_", " "), id),
+		)
+		return
+	}
+
+	// Save imported data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// WriteSurveySpec writes the survey spec of the template, enables or disables the survey, and saves the resulting
+// survey spec into the survey spec resource model. The password defaults which did not change from the previous
+// questions are left untouched in AAP.
+func (r *SurveySpecResource) WriteSurveySpec(ctx context.Context, data *SurveySpecResourceModel, previous []SurveyQuestionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	requestBody, diagsBody := data.CreateRequestBody(ctx, previous)
+	diags.Append(diagsBody...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// AAP answers survey spec updates with a 200 status
+	writeResponse, writeResponseBody, err := r.client.doRequest(http.MethodPost, data.SurveySpecURL(), bytes.NewReader(requestBody))
+	diags.Append(ValidateResponse(writeResponse, writeResponseBody, err, []int{http.StatusOK})...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Id = types.StringValue(data.SurveySpecURL())
+
+	diags.Append(r.setSurveyEnabled(data, data.Enabled.ValueBool())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, diagsRead := r.ReadSurveySpec(ctx, data)
+	diags.Append(diagsRead...)
+	return diags
+}
+
+// ReadSurveySpec saves the survey spec of the template into the survey spec resource model, and reports whether
+// the template still has a survey spec.
+func (r *SurveySpecResource) ReadSurveySpec(ctx context.Context, data *SurveySpecResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	templateResponse, templateResponseBody, err := r.client.doRequest(http.MethodGet, data.TemplateURL(), nil)
+	if templateResponse != nil && templateResponse.StatusCode == http.StatusNotFound {
+		return false, diags
+	}
+	diags.Append(ValidateResponse(templateResponse, templateResponseBody, err, []int{http.StatusOK})...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var template struct {
+		SurveyEnabled bool `json:"survey_enabled"`
+	}
+	err = json.Unmarshal(templateResponseBody, &template)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return false, diags
+	}
+
+	readResponseBody, diagsGet := r.client.Get(data.SurveySpecURL())
+	diags.Append(diagsGet...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	// AAP returns an empty object for templates without survey spec
+	var spec map[string]json.RawMessage
+	err = json.Unmarshal(readResponseBody, &spec)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return false, diags
+	}
+	if len(spec) == 0 {
+		return false, diags
+	}
+
+	diags.Append(data.ParseHttpResponse(ctx, readResponseBody)...)
+	data.Enabled = types.BoolValue(template.SurveyEnabled)
+	return true, diags
+}
+
+// setSurveyEnabled enables or disables the survey of the template.
+func (r *SurveySpecResource) setSurveyEnabled(data *SurveySpecResourceModel, enabled bool) diag.Diagnostics {
+	requestBody, err := json.Marshal(map[string]bool{"survey_enabled": enabled})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Body JSON Marshal Error", err.Error())
+		return diags
+	}
+
+	updateResponse, updateResponseBody, err := r.client.doRequest(http.MethodPatch, data.TemplateURL(), bytes.NewReader(requestBody))
+	return ValidateResponse(updateResponse, updateResponseBody, err, []int{http.StatusOK})
+}
+
+// TemplateURL returns the URL of the template the survey belongs to.
+func (r *SurveySpecResourceModel) TemplateURL() string {
+	if !r.WorkflowJobTemplate.IsNull() {
+		return fmt.Sprintf("/api/v2/workflow_job_templates/%d/", r.WorkflowJobTemplate.ValueInt64())
+	}
+	return fmt.Sprintf("/api/v2/job_templates/%d/", r.JobTemplate.ValueInt64())
+}
+
+// SurveySpecURL returns the URL of the survey spec of the template.
+func (r *SurveySpecResourceModel) SurveySpecURL() string {
+	return r.TemplateURL() + "survey_spec/"
+}
+
+// CreateRequestBody creates a JSON encoded request body from the survey spec resource data. The password defaults
+// which did not change from the previous questions are sent as encrypted, so that AAP keeps the stored values.
+func (r *SurveySpecResourceModel) CreateRequestBody(ctx context.Context, previous []SurveyQuestionModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	previousDefaults := map[string]types.String{}
+	for _, question := range previous {
+		if question.Type.ValueString() == "password" {
+			previousDefaults[question.Variable.ValueString()] = question.Default
+		}
+	}
+
+	// Convert survey spec resource data to API data model
+	surveySpec := SurveySpecAPIModel{
+		Name:        r.Name.ValueString(),
+		Description: r.Description.ValueString(),
+		Spec:        make([]SurveyQuestionAPIModel, 0, len(r.Questions)),
+	}
+	for _, question := range r.Questions {
+		apiQuestion := SurveyQuestionAPIModel{
+			Variable:            question.Variable.ValueString(),
+			QuestionName:        question.QuestionName.ValueString(),
+			QuestionDescription: question.QuestionDescription.ValueString(),
+			Type:                question.Type.ValueString(),
+			Required:            question.Required.ValueBool(),
+			Min:                 question.Min.ValueInt64Pointer(),
+			Max:                 question.Max.ValueInt64Pointer(),
+		}
+
+		if IsValueProvided(question.Choices) {
+			choices := make([]string, 0, len(question.Choices.Elements()))
+			diags.Append(question.Choices.ElementsAs(ctx, &choices, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			apiQuestion.Choices, _ = json.Marshal(choices)
+		}
+
+		if IsValueProvided(question.Default) {
+			defaultValue := question.Default.ValueString()
+			if previousDefault, found := previousDefaults[apiQuestion.Variable]; found && apiQuestion.Type == "password" &&
+				previousDefault.Equal(question.Default) {
+				defaultValue = encryptedValue
+			}
+			var err error
+			apiQuestion.Default, err = encodeSurveyDefault(apiQuestion.Type, defaultValue)
+			if err != nil {
+				diags.AddError(
+					"Error marshaling request body",
+					fmt.Sprintf("Invalid default of survey question %q: %s", apiQuestion.Variable, err.Error()),
+				)
+				return nil, diags
+			}
+		}
+
+		surveySpec.Spec = append(surveySpec.Spec, apiQuestion)
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(surveySpec)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for survey spec resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the survey spec resource data from an AAP API response. AAP returns the password
+// defaults as encrypted, so they keep their current value.
+func (r *SurveySpecResourceModel) ParseHttpResponse(ctx context.Context, body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiSurveySpec SurveySpecAPIModel
+	err := json.Unmarshal(body, &apiSurveySpec)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	currentQuestions := make(map[string]SurveyQuestionModel, len(r.Questions))
+	for _, question := range r.Questions {
+		currentQuestions[question.Variable.ValueString()] = question
+	}
+
+	// Map response to the survey spec resource schema and update attribute values
+	r.Name = ParseStringValue(apiSurveySpec.Name)
+	r.Description = ParseStringValue(apiSurveySpec.Description)
+
+	questions := make([]SurveyQuestionModel, 0, len(apiSurveySpec.Spec))
+	for _, apiQuestion := range apiSurveySpec.Spec {
+		question, found := currentQuestions[apiQuestion.Variable]
+		if !found {
+			question.Choices = types.ListNull(types.StringType)
+		}
+		question.Variable = types.StringValue(apiQuestion.Variable)
+		question.QuestionName = types.StringValue(apiQuestion.QuestionName)
+		question.QuestionDescription = ParseStringValue(apiQuestion.QuestionDescription)
+		question.Type = types.StringValue(apiQuestion.Type)
+		question.Min = types.Int64PointerValue(apiQuestion.Min)
+		question.Max = types.Int64PointerValue(apiQuestion.Max)
+
+		// Questions which are not required keep a null value when it is not configured
+		if apiQuestion.Required || !question.Required.IsNull() {
+			question.Required = types.BoolValue(apiQuestion.Required)
+		}
+
+		choices := decodeSurveyChoices(apiQuestion.Choices)
+		if len(choices) > 0 || !question.Choices.IsNull() {
+			choiceValues := make([]attr.Value, 0, len(choices))
+			for _, choice := range choices {
+				choiceValues = append(choiceValues, types.StringValue(choice))
+			}
+			var diagsChoices diag.Diagnostics
+			question.Choices, diagsChoices = types.ListValue(types.StringType, choiceValues)
+			diags.Append(diagsChoices...)
+		}
+
+		defaultValue := decodeSurveyDefault(apiQuestion.Default)
+		if defaultValue != encryptedValue {
+			question.Default = ParseStringValue(defaultValue)
+		}
+
+		questions = append(questions, question)
+	}
+	r.Questions = questions
+
+	return diags
+}
+
+// encodeSurveyDefault encodes the default answer of a survey question, as a number for the numeric types.
+func encodeSurveyDefault(questionType string, value string) (json.RawMessage, error) {
+	switch questionType {
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return json.RawMessage(value), nil
+	case "float":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return json.RawMessage(value), nil
+	default:
+		return json.Marshal(value)
+	}
+}
+
+// decodeSurveyDefault returns the default answer of a survey question returned by AAP as a string.
+func decodeSurveyDefault(value json.RawMessage) string {
+	var stringValue string
+	if err := json.Unmarshal(value, &stringValue); err == nil {
+		return stringValue
+	}
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+	return string(value)
+}
+
+// decodeSurveyChoices returns the choices of a survey question returned by AAP, either as a list or as a string
+// with one choice per line.
+func decodeSurveyChoices(value json.RawMessage) []string {
+	var choices []string
+	if err := json.Unmarshal(value, &choices); err == nil {
+		return choices
+	}
+
+	var stringValue string
+	_ = json.Unmarshal(value, &stringValue)
+	for _, choice := range strings.Split(stringValue, "\n") {
+		if choice != "" {
+			choices = append(choices, choice)
+		}
+	}
+	return choices
+}
+
+// validateSurveyQuestions checks that the survey questions have unique variables and the attributes matching their type.
+func validateSurveyQuestions(questions []SurveyQuestionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	variables := make(map[string]bool, len(questions))
+	for i, question := range questions {
+		questionPath := path.Root("question").AtListIndex(i)
+
+		if !question.Variable.IsUnknown() && !question.Variable.IsNull() {
+			if variables[question.Variable.ValueString()] {
+				diags.AddAttributeError(
+					questionPath.AtName("variable"),
+					"Duplicate survey question variable",
+					fmt.Sprintf("The variable %q is used by more than one question.", question.Variable.ValueString()),
+				)
+			}
+			variables[question.Variable.ValueString()] = true
+		}
+
+		if question.Type.IsUnknown() || question.Type.IsNull() {
+			continue
+		}
+		questionType := question.Type.ValueString()
+
+		isChoiceType := slices.Contains(surveyChoiceTypes, questionType)
+		if isChoiceType && question.Choices.IsNull() {
+			diags.AddAttributeError(
+				questionPath.AtName("choices"),
+				"Missing survey question choices",
+				fmt.Sprintf("The choices are required for the %s type.", questionType),
+			)
+		}
+		if !isChoiceType && !question.Choices.IsNull() {
+			diags.AddAttributeError(
+				questionPath.AtName("choices"),
+				"Unexpected survey question choices",
+				fmt.Sprintf("The choices are not allowed for the %s type.", questionType),
+			)
+		}
+
+		if isChoiceType && (!question.Min.IsNull() || !question.Max.IsNull()) {
+			diags.AddAttributeError(
+				questionPath,
+				"Unexpected survey question bounds",
+				fmt.Sprintf("The min and max are not allowed for the %s type.", questionType),
+			)
+		}
+		if IsValueProvided(question.Min) && IsValueProvided(question.Max) && question.Min.ValueInt64() > question.Max.ValueInt64() {
+			diags.AddAttributeError(
+				questionPath.AtName("min"),
+				"Invalid survey question bounds",
+				"The min must not be greater than the max.",
+			)
+		}
+
+		if IsValueProvided(question.Default) {
+			if _, err := encodeSurveyDefault(questionType, question.Default.ValueString()); err != nil {
+				diags.AddAttributeError(questionPath.AtName("default"), "Invalid survey question default", err.Error())
+			}
+		}
+	}
+
+	return diags
+}
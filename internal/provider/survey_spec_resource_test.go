@@ -0,0 +1,417 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestSurveySpecResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the SurveySpecResource and call its Schema method
+	NewSurveySpecResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// testSurveyQuestion returns a survey question model with null optional values.
+func testSurveyQuestion(variable string, questionType string) SurveyQuestionModel {
+	return SurveyQuestionModel{
+		Variable:            types.StringValue(variable),
+		QuestionName:        types.StringValue("Value of " + variable),
+		QuestionDescription: types.StringNull(),
+		Type:                types.StringValue(questionType),
+		Required:            types.BoolNull(),
+		Choices:             types.ListNull(types.StringType),
+		Min:                 types.Int64Null(),
+		Max:                 types.Int64Null(),
+		Default:             types.StringNull(),
+	}
+}
+
+func TestSurveySpecResourceTemplateURL(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    SurveySpecResourceModel
+		expected string
+	}{
+		{
+			name: "job template",
+			input: SurveySpecResourceModel{
+				JobTemplate:         types.Int64Value(7),
+				WorkflowJobTemplate: types.Int64Null(),
+			},
+			expected: "/api/v2/job_templates/7/survey_spec/",
+		},
+		{
+			name: "workflow job template",
+			input: SurveySpecResourceModel{
+				JobTemplate:         types.Int64Null(),
+				WorkflowJobTemplate: types.Int64Value(3),
+			},
+			expected: "/api/v2/workflow_job_templates/3/survey_spec/",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.input.SurveySpecURL()
+			if actual != test.expected {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSurveySpecResourceCreateRequestBody(t *testing.T) {
+	region := testSurveyQuestion("region", "multiselect")
+	region.Required = types.BoolValue(true)
+	region.Choices = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu"), types.StringValue("us")})
+	region.Default = types.StringValue("eu\nus")
+
+	replicas := testSurveyQuestion("replicas", "integer")
+	replicas.Min = types.Int64Value(1)
+	replicas.Max = types.Int64Value(5)
+	replicas.Default = types.StringValue("3")
+
+	password := testSurveyQuestion("password", "password")
+	password.Default = types.StringValue("secret")
+
+	changedPassword := password
+	changedPassword.Default = types.StringValue("new-secret")
+
+	var testTable = []struct {
+		name     string
+		input    SurveySpecResourceModel
+		previous []SurveyQuestionModel
+		expected []byte
+	}{
+		{
+			name: "typed questions",
+			input: SurveySpecResourceModel{
+				Name:        types.StringValue("Deployment"),
+				Description: types.StringNull(),
+				Questions:   []SurveyQuestionModel{region, replicas},
+			},
+			expected: []byte(`{"name":"Deployment","description":"","spec":[` +
+				`{"variable":"region","question_name":"Value of region","question_description":"","type":"multiselect",` +
+				`"required":true,"choices":["eu","us"],"default":"eu\nus"},` +
+				`{"variable":"replicas","question_name":"Value of replicas","question_description":"","type":"integer",` +
+				`"required":false,"min":1,"max":5,"default":3}]}`),
+		},
+		{
+			name: "new password default",
+			input: SurveySpecResourceModel{
+				Questions: []SurveyQuestionModel{password},
+			},
+			expected: []byte(`{"name":"","description":"","spec":[{"variable":"password","question_name":"Value of password",` +
+				`"question_description":"","type":"password","required":false,"default":"secret"}]}`),
+		},
+		{
+			name: "unchanged password default",
+			input: SurveySpecResourceModel{
+				Questions: []SurveyQuestionModel{password},
+			},
+			previous: []SurveyQuestionModel{password},
+			expected: []byte(`{"name":"","description":"","spec":[{"variable":"password","question_name":"Value of password",` +
+				`"question_description":"","type":"password","required":false,"default":"$encrypted$"}]}`),
+		},
+		{
+			name: "changed password default",
+			input: SurveySpecResourceModel{
+				Questions: []SurveyQuestionModel{changedPassword},
+			},
+			previous: []SurveyQuestionModel{password},
+			expected: []byte(`{"name":"","description":"","spec":[{"variable":"password","question_name":"Value of password",` +
+				`"question_description":"","type":"password","required":false,"default":"new-secret"}]}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody(context.Background(), test.previous)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSurveySpecResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	password := testSurveyQuestion("password", "password")
+	password.Default = types.StringValue("secret")
+
+	region := testSurveyQuestion("region", "multiplechoice")
+	region.Required = types.BoolValue(true)
+	region.Choices = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu"), types.StringValue("us")})
+
+	replicas := testSurveyQuestion("replicas", "integer")
+	replicas.Default = types.StringValue("3")
+
+	var testTable = []struct {
+		name     string
+		current  []SurveyQuestionModel
+		input    []byte
+		expected SurveySpecResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: SurveySpecResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "choices as string and numeric default",
+			input: []byte(`{"name":"Deployment","description":"","spec":[` +
+				`{"variable":"region","question_name":"Value of region","question_description":"","type":"multiplechoice",` +
+				`"required":true,"choices":"eu\nus","default":""},` +
+				`{"variable":"replicas","question_name":"Value of replicas","question_description":"","type":"integer",` +
+				`"required":false,"min":null,"max":null,"default":3}]}`),
+			expected: SurveySpecResourceModel{
+				Name:        types.StringValue("Deployment"),
+				Description: types.StringNull(),
+				Questions:   []SurveyQuestionModel{region, replicas},
+			},
+			errors: diag.Diagnostics{},
+		},
+		{
+			name:    "encrypted password default",
+			current: []SurveyQuestionModel{password},
+			input: []byte(`{"name":"","description":"","spec":[{"variable":"password","question_name":"Value of password",` +
+				`"question_description":"","type":"password","required":false,"default":"$encrypted$"}]}`),
+			expected: SurveySpecResourceModel{
+				Name:        types.StringNull(),
+				Description: types.StringNull(),
+				Questions:   []SurveyQuestionModel{password},
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := SurveySpecResourceModel{Questions: test.current}
+			diags := resource.ParseHttpResponse(context.Background(), test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestValidateSurveyQuestions(t *testing.T) {
+	region := testSurveyQuestion("region", "multiplechoice")
+	region.Choices = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu")})
+
+	regionWithBounds := region
+	regionWithBounds.Min = types.Int64Value(1)
+
+	replicas := testSurveyQuestion("replicas", "integer")
+	replicas.Min = types.Int64Value(5)
+	replicas.Max = types.Int64Value(1)
+
+	invalidDefault := testSurveyQuestion("replicas", "float")
+	invalidDefault.Default = types.StringValue("many")
+
+	textWithChoices := testSurveyQuestion("name", "text")
+	textWithChoices.Choices = region.Choices
+
+	var testTable = []struct {
+		name     string
+		input    []SurveyQuestionModel
+		expected []string
+	}{
+		{
+			name:     "valid questions",
+			input:    []SurveyQuestionModel{region, testSurveyQuestion("name", "text")},
+			expected: nil,
+		},
+		{
+			name:     "duplicate variable",
+			input:    []SurveyQuestionModel{testSurveyQuestion("name", "text"), testSurveyQuestion("name", "textarea")},
+			expected: []string{"Duplicate survey question variable"},
+		},
+		{
+			name:     "missing choices",
+			input:    []SurveyQuestionModel{testSurveyQuestion("region", "multiselect")},
+			expected: []string{"Missing survey question choices"},
+		},
+		{
+			name:     "unexpected choices",
+			input:    []SurveyQuestionModel{textWithChoices},
+			expected: []string{"Unexpected survey question choices"},
+		},
+		{
+			name:     "bounds on choices",
+			input:    []SurveyQuestionModel{regionWithBounds},
+			expected: []string{"Unexpected survey question bounds"},
+		},
+		{
+			name:     "min greater than max",
+			input:    []SurveyQuestionModel{replicas},
+			expected: []string{"Invalid survey question bounds"},
+		},
+		{
+			name:     "invalid numeric default",
+			input:    []SurveyQuestionModel{invalidDefault},
+			expected: []string{"Invalid survey question default"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			var actual []string
+			for _, err := range validateSurveyQuestions(test.input).Errors() {
+				actual = append(actual, err.Summary())
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, actual)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccSurveySpecResource(t *testing.T) {
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSurveySpecResource(randomName, jobTemplateID, "secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSurveySpecResourceExists("aap_survey_spec.test"),
+					resource.TestCheckResourceAttr("aap_survey_spec.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_survey_spec.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("aap_survey_spec.test", "question.#", "2"),
+					resource.TestCheckResourceAttr("aap_survey_spec.test", "question.0.choices.#", "2"),
+					resource.TestCheckResourceAttr("aap_survey_spec.test", "question.1.default", "secret"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSurveySpecResource(randomName, jobTemplateID, "new-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSurveySpecResourceExists("aap_survey_spec.test"),
+					resource.TestCheckResourceAttr("aap_survey_spec.test", "question.1.default", "new-secret"),
+				),
+			},
+			// Import testing, the password default is never returned by AAP
+			{
+				ResourceName:            "aap_survey_spec.test",
+				ImportState:             true,
+				ImportStateId:           "job_template/" + jobTemplateID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"question.1.default"},
+			},
+		},
+		CheckDestroy: testAccCheckSurveySpecResourceDestroy,
+	})
+}
+
+// testAccSurveySpecResource returns a configuration for an AAP Survey Spec with a choice and a password question.
+func testAccSurveySpecResource(name string, jobTemplateID string, password string) string {
+	return fmt.Sprintf(`
+resource "aap_survey_spec" "test" {
+  job_template = %[2]s
+  name         = "%[1]s"
+
+  question {
+    variable      = "region"
+    question_name = "Region"
+    type          = "multiplechoice"
+    required      = true
+    choices       = ["eu", "us"]
+  }
+
+  question {
+    variable      = "api_password"
+    question_name = "API password"
+    type          = "password"
+    default       = "%[3]s"
+  }
+}`, name, jobTemplateID, password)
+}
+
+// testAccCheckSurveySpecResourceExists queries the AAP API and checks that the template has a survey spec.
+func testAccCheckSurveySpecResourceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		surveySpecResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("survey spec (%s) not found in state", name)
+		}
+
+		surveySpecResponseBody, err := testGetResource(surveySpecResource.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if string(surveySpecResponseBody) == "{}" {
+			return fmt.Errorf("survey spec (%s) not found in AAP", surveySpecResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSurveySpecResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_survey_spec" {
+			continue
+		}
+
+		surveySpecResponseBody, err := testGetResource(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if string(surveySpecResponseBody) != "{}" {
+			return fmt.Errorf("survey spec (%s) still exists.", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// System job AAP API model
+type SystemJobAPIModel struct {
+	ID         int64  `json:"id,omitempty"`
+	TemplateID int64  `json:"system_job_template,omitempty"`
+	Type       string `json:"job_type,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Status     string `json:"status,omitempty"`
+}
+
+// System job launch AAP API model
+type SystemJobLaunchAPIModel struct {
+	ExtraVars SystemJobExtraVarsAPIModel `json:"extra_vars"`
+}
+
+// Extra variables accepted by the system job templates
+type SystemJobExtraVarsAPIModel struct {
+	Days   *int64 `json:"days,omitempty"`
+	DryRun *bool  `json:"dry_run,omitempty"`
+}
+
+// SystemJobResourceModel maps the system job resource schema data.
+type SystemJobResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	TemplateID        types.Int64  `tfsdk:"system_job_template_id"`
+	Type              types.String `tfsdk:"job_type"`
+	URL               types.String `tfsdk:"url"`
+	Status            types.String `tfsdk:"status"`
+	Days              types.Int64  `tfsdk:"days"`
+	DryRun            types.Bool   `tfsdk:"dry_run"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+}
+
+// SystemJobResource is the resource implementation.
+type SystemJobResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &SystemJobResource{}
+	_ resource.ResourceWithConfigure = &SystemJobResource{}
+)
+
+// NewSystemJobResource is a helper function to simplify the provider implementation.
+func NewSystemJobResource() resource.Resource {
+	return &SystemJobResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *SystemJobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_job"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SystemJobResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the system job resource.
+func (r *SystemJobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Id of the system job",
+			},
+			"system_job_template_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Id of the system job template.",
+			},
+			"job_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the system job, such as cleanup_jobs or cleanup_activitystream",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the system job",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the system job",
+			},
+			"days": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "Number of days of data to keep. If not provided, the default of the system job template is used.",
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, report what the system job would remove without removing anything.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, wait for the system job to finish. Defaults to false.",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Map of arbitrary keys and values that, when changed, will trigger a creation" +
+					" of a new System Job on AAP. Use 'terraform taint' if you want to force the creation of a new system job" +
+					" without changing this value.",
+			},
+		},
+	}
+}
+
+// Create launches the system job and sets the Terraform state on success.
+func (r *SystemJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemJobResourceModel
+
+	// Read Terraform plan data into system job resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.LaunchSystemJob(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state, before reporting the status so that the launched system job is tracked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.CheckStatus()...)
+}
+
+// Read refreshes the Terraform state with the latest system job data.
+func (r *SystemJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemJobResourceModel
+
+	// Read current Terraform state data into system job resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest system job data from AAP
+	readResponseBody, diags := r.client.Get(data.URL.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest system job data into system job resource model
+	resp.Diagnostics.Append(data.ParseHttpResponse(readResponseBody)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update launches a new system job when the launch configuration has changed.
+func (r *SystemJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SystemJobResourceModel
+
+	// Read Terraform plan and state data into system job resource models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relaunched := data.RequiresRelaunch(state)
+	if relaunched {
+		// Create new system job from system job template
+		resp.Diagnostics.Append(r.LaunchSystemJob(&data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Only wait_for_completion changed, keep the current system job
+		data.ID = state.ID
+		data.Type = state.Type
+		data.URL = state.URL
+		data.Status = state.Status
+	}
+
+	// Save updated data into Terraform state, before reporting the status so that the launched system job is tracked
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if relaunched {
+		resp.Diagnostics.Append(data.CheckStatus()...)
+	}
+}
+
+// Delete does nothing, system jobs are kept on AAP.
+func (r *SystemJobResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// CreateRequestBody creates a JSON encoded request body from the system job resource data
+func (r *SystemJobResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert system job resource data to API data model
+	systemJob := SystemJobLaunchAPIModel{
+		ExtraVars: SystemJobExtraVarsAPIModel{
+			Days:   r.Days.ValueInt64Pointer(),
+			DryRun: r.DryRun.ValueBoolPointer(),
+		},
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(systemJob)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for system job resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the system job resource data from an AAP API response
+func (r *SystemJobResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var resultApiSystemJob SystemJobAPIModel
+	err := json.Unmarshal(body, &resultApiSystemJob)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the system job resource schema and update attribute values
+	r.ID = types.Int64Value(resultApiSystemJob.ID)
+	r.TemplateID = types.Int64Value(resultApiSystemJob.TemplateID)
+	r.Type = types.StringValue(resultApiSystemJob.Type)
+	r.URL = types.StringValue(resultApiSystemJob.URL)
+	r.Status = types.StringValue(resultApiSystemJob.Status)
+
+	return diags
+}
+
+// RequiresRelaunch returns true when the configuration used to launch the system job differs from the provided state.
+func (r *SystemJobResourceModel) RequiresRelaunch(state SystemJobResourceModel) bool {
+	return !r.TemplateID.Equal(state.TemplateID) ||
+		!r.Days.Equal(state.Days) ||
+		!r.DryRun.Equal(state.DryRun) ||
+		!r.Triggers.Equal(state.Triggers)
+}
+
+// LaunchSystemJob launches a system job from the system job template and optionally waits for it to finish.
+func (r *SystemJobResource) LaunchSystemJob(data *SystemJobResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Create request body from system job data
+	requestBody, diagCreateReq := data.CreateRequestBody()
+	diags.Append(diagCreateReq...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var postURL = fmt.Sprintf("/api/v2/system_job_templates/%d/launch/", data.TemplateID.ValueInt64())
+	body, diagsCreate := r.client.Create(postURL, bytes.NewReader(requestBody))
+	diags.Append(diagsCreate...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Save new system job data into system job resource model
+	diags.Append(data.ParseHttpResponse(body)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if data.WaitForCompletion.ValueBool() {
+		status, diagsWait := waitForJob(r.client, data.URL.ValueString(), jobWaitTimeout)
+		diags.Append(diagsWait...)
+		if diags.HasError() {
+			return diags
+		}
+		data.Status = types.StringValue(status)
+	}
+
+	return diags
+}
+
+// CheckStatus returns an error when the system job was waited for and did not succeed.
+func (r *SystemJobResourceModel) CheckStatus() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.WaitForCompletion.ValueBool() && r.Status.ValueString() != jobStatusSuccessful {
+		diags.AddError(
+			"System job did not succeed",
+			fmt.Sprintf("System job %s finished with status %s", r.URL.ValueString(), r.Status.ValueString()),
+		)
+	}
+
+	return diags
+}
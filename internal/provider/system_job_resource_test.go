@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSystemJobResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the SystemJobResource and call its Schema method
+	NewSystemJobResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestSystemJobResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    SystemJobResourceModel
+		expected []byte
+	}{
+		{
+			name: "null values",
+			input: SystemJobResourceModel{
+				TemplateID: types.Int64Value(1),
+				Days:       types.Int64Null(),
+				DryRun:     types.BoolNull(),
+			},
+			expected: []byte(`{"extra_vars":{}}`),
+		},
+		{
+			name: "days only",
+			input: SystemJobResourceModel{
+				TemplateID: types.Int64Value(1),
+				Days:       types.Int64Value(30),
+				DryRun:     types.BoolNull(),
+			},
+			expected: []byte(`{"extra_vars":{"days":30}}`),
+		},
+		{
+			name: "all values",
+			input: SystemJobResourceModel{
+				TemplateID: types.Int64Value(1),
+				Days:       types.Int64Value(0),
+				DryRun:     types.BoolValue(false),
+			},
+			expected: []byte(`{"extra_vars":{"days":0,"dry_run":false}}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSystemJobResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected SystemJobResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: SystemJobResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "system job",
+			input: []byte(`{"id":5,"system_job_template":1,"job_type":"cleanup_jobs","url":"/api/v2/system_jobs/5/",` +
+				`"status":"pending","extra_vars":"{\"days\": 30}"}`),
+			expected: SystemJobResourceModel{
+				ID:         types.Int64Value(5),
+				TemplateID: types.Int64Value(1),
+				Type:       types.StringValue("cleanup_jobs"),
+				URL:        types.StringValue("/api/v2/system_jobs/5/"),
+				Status:     types.StringValue("pending"),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := SystemJobResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestSystemJobResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewSystemJobResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	var testTable = []struct {
+		name     string
+		status   string
+		expected []string
+	}{
+		{"successful system job", "successful", nil},
+		{"failed system job", "failed", []string{"System job did not succeed"}},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			r := SystemJobResource{client: NewMockRawHTTPClient(map[string]MockRawResponse{
+				"POST /api/v2/system_job_templates/1/launch/": {
+					StatusCode: http.StatusCreated,
+					Body: `{"id":12,"system_job_template":1,"job_type":"cleanup_jobs",` +
+						`"url":"/api/v2/system_jobs/12/","status":"pending"}`,
+				},
+				"GET /api/v2/system_jobs/12/": {
+					StatusCode: http.StatusOK,
+					Body:       fmt.Sprintf(`{"id":12,"url":"/api/v2/system_jobs/12/","status":"%s"}`, test.status),
+				},
+			})}
+
+			plan := tfsdk.Plan{
+				Schema: schemaResponse.Schema,
+				Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := plan.Set(ctx, &SystemJobResourceModel{
+				ID:                types.Int64Unknown(),
+				TemplateID:        types.Int64Value(1),
+				Type:              types.StringUnknown(),
+				URL:               types.StringUnknown(),
+				Status:            types.StringUnknown(),
+				Days:              types.Int64Value(30),
+				DryRun:            types.BoolNull(),
+				WaitForCompletion: types.BoolValue(true),
+				Triggers:          types.MapNull(types.StringType),
+			})
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+
+			req := fwresource.CreateRequest{Plan: plan}
+			resp := &fwresource.CreateResponse{
+				State: tfsdk.State{
+					Schema: schemaResponse.Schema,
+					Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.Create(ctx, req, resp)
+
+			var actual []string
+			for _, err := range resp.Diagnostics.Errors() {
+				actual = append(actual, err.Summary())
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected errors (%v) not equal to actual (%v)", test.expected, actual)
+			}
+
+			// The launched system job is saved into the state even when it did not succeed
+			var state SystemJobResourceModel
+			diags = resp.State.Get(ctx, &state)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if state.URL.ValueString() != "/api/v2/system_jobs/12/" || state.Status.ValueString() != test.status {
+				t.Errorf("Expected launched system job (/api/v2/system_jobs/12/, %s), actual was (%s, %s)",
+					test.status, state.URL.ValueString(), state.Status.ValueString())
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func testAccSystemJobResourcePreCheck(t *testing.T) {
+	// Ensure provider requirements
+	testAccPreCheck(t)
+
+	if v := os.Getenv("AAP_TEST_SYSTEM_JOB_TEMPLATE_ID"); v == "" {
+		t.Fatal("'AAP_TEST_SYSTEM_JOB_TEMPLATE_ID' environment variable must be set when running acceptance tests for system job resource")
+	}
+}
+
+func TestAccSystemJobResource(t *testing.T) {
+	systemJobTemplateID := os.Getenv("AAP_TEST_SYSTEM_JOB_TEMPLATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccSystemJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSystemJobResource(systemJobTemplateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aap_system_job.test", "system_job_template_id", systemJobTemplateID),
+					resource.TestCheckResourceAttr("aap_system_job.test", "status", "successful"),
+					resource.TestMatchResourceAttr("aap_system_job.test", "url", regexp.MustCompile("^/api/v2/system_jobs/[0-9]*/$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccSystemJobResource(systemJobTemplateID string) string {
+	return fmt.Sprintf(`
+resource "aap_system_job" "test" {
+  system_job_template_id = %s
+  days                   = 365
+  dry_run                = true
+  wait_for_completion    = true
+}
+`, systemJobTemplateID)
+}
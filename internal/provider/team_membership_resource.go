@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TeamMembershipResourceModel maps the team membership resource schema to a Go struct.
+type TeamMembershipResourceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Team types.Int64  `tfsdk:"team"`
+	User types.Int64  `tfsdk:"user"`
+}
+
+// TeamMembershipResource is the resource implementation.
+type TeamMembershipResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+)
+
+// NewTeamMembershipResource is a helper function to simplify the provider implementation.
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *TeamMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TeamMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the team membership resource.
+func (r *TeamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Identifier of the membership, made of the URL listing the team members and the user id.",
+			},
+			"team": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the team the user is a member of.",
+			},
+			"user": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Identifier for the user member of the team.",
+			},
+		},
+	}
+}
+
+// Create adds the user to the team and sets the Terraform state on success.
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMembershipResourceModel
+
+	// Read Terraform plan data into team membership resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add user to team in AAP
+	resp.Diagnostics.Append(associateIDs(r.client, data.MembersURL(), []int64{data.User.ValueInt64()}, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s%d", data.MembersURL(), data.User.ValueInt64()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state, removing the membership when the user is no longer a member of the team.
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMembershipResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into team membership resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get team members from AAP
+	memberIDs, diags := readAssociatedIDs(r.client, data.MembersURL())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !slices.Contains(memberIDs, data.User.ValueInt64()) {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+// Update is never called, since changing any attribute replaces the membership.
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamMembershipResourceModel
+
+	// Read Terraform plan data into team membership resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the user from the team.
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMembershipResourceModel
+
+	// Read current Terraform state data into team membership resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove user from team in AAP
+	resp.Diagnostics.Append(associateIDs(r.client, data.MembersURL(), []int64{data.User.ValueInt64()}, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ImportState imports an existing membership from the team name and the username, separated by a slash.
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamName, username, found := cutLast(req.ID, "/")
+	if !found || teamName == "" || username == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the team name and the username separated by a slash, got: %s", req.ID),
+		)
+		return
+	}
+
+	var team TeamAPIModel
+	var user UserAPIModel
+	for _, lookup := range []struct {
+		listPath, field, value string
+		target                 interface{}
+	}{
+		{"/api/v2/teams/", "name", teamName, &team},
+		{"/api/v2/users/", "username", username, &user},
+	} {
+		readResponseBody, diags := getResourceByField(r.client, lookup.listPath, lookup.field, lookup.value)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := json.Unmarshal(readResponseBody, lookup.target)
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing JSON response from AAP", err.Error())
+			return
+		}
+	}
+
+	data := TeamMembershipResourceModel{
+		Team: types.Int64Value(team.Id),
+		User: types.Int64Value(user.Id),
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s%d", data.MembersURL(), user.Id))
+
+	// Save imported data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// MembersURL returns the URL listing the members of the team.
+func (r *TeamMembershipResourceModel) MembersURL() string {
+	return fmt.Sprintf("/api/v2/teams/%d/users/", r.Team.ValueInt64())
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestTeamMembershipResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the TeamMembershipResource and call its Schema method
+	NewTeamMembershipResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// Acceptance tests
+
+func TestAccTeamMembershipResource(t *testing.T) {
+	randomName := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMembershipResource(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamMember("aap_team_membership.test"),
+					resource.TestCheckResourceAttrPair("aap_team_membership.test", "team", "aap_team.test", "id"),
+					resource.TestCheckResourceAttrPair("aap_team_membership.test", "user", "aap_user.test", "id"),
+				),
+			},
+			// Import by team name and username testing
+			{
+				ResourceName:      "aap_team_membership.test",
+				ImportState:       true,
+				ImportStateId:     randomName + "/" + randomName,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckUserResourceDestroy,
+	})
+}
+
+// testAccTeamMembershipResource returns a configuration adding a new user to a new team.
+func testAccTeamMembershipResource(name string) string {
+	return fmt.Sprintf(`
+resource "aap_team" "test" {
+  name         = "%[1]s"
+  organization = 1
+}
+
+resource "aap_user" "test" {
+  username = "%[1]s"
+  password = "Terraform-test-123!"
+}
+
+resource "aap_team_membership" "test" {
+  team = aap_team.test.id
+  user = aap_user.test.id
+}`, name)
+}
+
+// testAccCheckTeamMember queries the AAP API and verifies the user is a member of the team.
+func testAccCheckTeamMember(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		membershipResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("team membership (%s) not found in state", name)
+		}
+
+		userID := membershipResource.Primary.Attributes["user"]
+		membersResponseBody, err := testGetResource(strings.TrimSuffix(membershipResource.Primary.ID, userID))
+		if err != nil {
+			return err
+		}
+
+		var members listAPIModel
+		err = json.Unmarshal(membersResponseBody, &members)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range members.Results {
+			var user UserAPIModel
+			err = json.Unmarshal(result, &user)
+			if err != nil {
+				return err
+			}
+			if strconv.FormatInt(user.Id, 10) == userID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("user (%s) not a member of the team in AAP", userID)
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestTeamResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the TeamResource and call its Schema method
+	NewTeamResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestTeamResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    TeamResourceModel
+		expected []byte
+	}{
+		{
+			name: "required values",
+			input: TeamResourceModel{
+				Name:         types.StringValue("ops"),
+				Description:  types.StringNull(),
+				Organization: types.Int64Value(1),
+			},
+			expected: []byte(`{"name":"ops","description":"","organization":1}`),
+		},
+		{
+			name: "provided values",
+			input: TeamResourceModel{
+				Name:         types.StringValue("ops"),
+				Description:  types.StringValue("Operations team"),
+				Organization: types.Int64Value(2),
+			},
+			expected: []byte(`{"name":"ops","description":"Operations team","organization":2}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTeamResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected TeamResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: TeamResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "provided values",
+			input: []byte(`{"id":2,"url":"/api/v2/teams/2/","name":"ops","description":"Operations team","organization":1}`),
+			expected: TeamResourceModel{
+				Id:           types.Int64Value(2),
+				Url:          types.StringValue("/api/v2/teams/2/"),
+				Name:         types.StringValue("ops"),
+				Description:  types.StringValue("Operations team"),
+				Organization: types.Int64Value(1),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := TeamResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccTeamResource(t *testing.T) {
+	var team TeamAPIModel
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamResource(randomName, "A test team"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamResourceExists("aap_team.test", &team),
+					resource.TestCheckResourceAttr("aap_team.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_team.test", "organization", "1"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamResource(randomName, "An updated test team"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamResourceExists("aap_team.test", &team),
+					resource.TestCheckResourceAttr("aap_team.test", "description", "An updated test team"),
+				),
+			},
+			// Import by organization name and name testing
+			{
+				ResourceName:      "aap_team.test",
+				ImportState:       true,
+				ImportStateId:     "Default/" + randomName,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckTeamResourceDestroy,
+	})
+}
+
+// testAccTeamResource returns a configuration for an AAP Team with the provided description.
+func testAccTeamResource(name string, description string) string {
+	return fmt.Sprintf(`
+resource "aap_team" "test" {
+  name         = "%s"
+  description  = "%s"
+  organization = 1
+}`, name, description)
+}
+
+// testAccCheckTeamResourceExists queries the AAP API and retrieves the matching team.
+func testAccCheckTeamResourceExists(name string, team *TeamAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		teamResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("team (%s) not found in state", name)
+		}
+
+		teamResponseBody, err := testGetResource(teamResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(teamResponseBody, &team)
+		if err != nil {
+			return err
+		}
+
+		if team.Id == 0 {
+			return fmt.Errorf("team (%s) not found in AAP", teamResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckTeamResourceDestroy verifies the team has been destroyed.
+func testAccCheckTeamResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_team" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("team (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
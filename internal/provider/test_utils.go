@@ -99,3 +99,57 @@ func (c *MockHTTPClient) Delete(path string) ([]byte, diag.Diagnostics) {
 	diags := ValidateResponse(deleteResponse, body, err, []int{http.StatusNoContent})
 	return body, diags
 }
+
+// MockRawResponse is the status code and the raw body returned by a MockRawHTTPClient.
+type MockRawResponse struct {
+	StatusCode int
+	Body       string
+}
+
+// MockRawHTTPClient returns the configured raw responses, keyed by the method and the path of the request
+// separated by a space, such as "GET /api/v2/jobs/1/".
+type MockRawHTTPClient struct {
+	responses map[string]MockRawResponse
+}
+
+func NewMockRawHTTPClient(responses map[string]MockRawResponse) *MockRawHTTPClient {
+	return &MockRawHTTPClient{
+		responses: responses,
+	}
+}
+
+func (c *MockRawHTTPClient) doRequest(method string, path string, _ io.Reader) (*http.Response, []byte, error) {
+	request, err := http.NewRequest(method, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	response, ok := c.responses[method+" "+path]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Request: request}, nil, nil
+	}
+	return &http.Response{StatusCode: response.StatusCode, Request: request}, []byte(response.Body), nil
+}
+
+func (c *MockRawHTTPClient) Create(path string, data io.Reader) ([]byte, diag.Diagnostics) {
+	createResponse, body, err := c.doRequest("POST", path, data)
+	diags := ValidateResponse(createResponse, body, err, []int{http.StatusCreated})
+	return body, diags
+}
+
+func (c *MockRawHTTPClient) Get(path string) ([]byte, diag.Diagnostics) {
+	getResponse, body, err := c.doRequest("GET", path, nil)
+	diags := ValidateResponse(getResponse, body, err, []int{http.StatusOK})
+	return body, diags
+}
+
+func (c *MockRawHTTPClient) Update(path string, data io.Reader) ([]byte, diag.Diagnostics) {
+	updateResponse, body, err := c.doRequest("PUT", path, data)
+	diags := ValidateResponse(updateResponse, body, err, []int{http.StatusOK})
+	return body, diags
+}
+
+func (c *MockRawHTTPClient) Delete(path string) ([]byte, diag.Diagnostics) {
+	deleteResponse, body, err := c.doRequest("DELETE", path, nil)
+	diags := ValidateResponse(deleteResponse, body, err, []int{http.StatusNoContent})
+	return body, diags
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUserResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the UserResource and call its Schema method
+	NewUserResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestUserResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    UserResourceModel
+		expected []byte
+	}{
+		{
+			name: "without password",
+			input: UserResourceModel{
+				Username:    types.StringValue("jdoe"),
+				Email:       types.StringNull(),
+				FirstName:   types.StringNull(),
+				LastName:    types.StringNull(),
+				IsSuperuser: types.BoolValue(false),
+				Password:    types.StringNull(),
+			},
+			expected: []byte(`{"username":"jdoe","email":"","first_name":"","last_name":"","is_superuser":false}`),
+		},
+		{
+			name: "provided values",
+			input: UserResourceModel{
+				Username:    types.StringValue("jdoe"),
+				Email:       types.StringValue("jdoe@example.com"),
+				FirstName:   types.StringValue("John"),
+				LastName:    types.StringValue("Doe"),
+				IsSuperuser: types.BoolValue(true),
+				Password:    types.StringValue("s3cret!"),
+			},
+			expected: []byte(`{"username":"jdoe","email":"jdoe@example.com","first_name":"John","last_name":"Doe",` +
+				`"is_superuser":true,"password":"s3cret!"}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestUserResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected UserResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: UserResourceModel{Password: types.StringValue("s3cret!")},
+			errors:   jsonError,
+		},
+		{
+			name: "password is kept",
+			input: []byte(`{"id":4,"url":"/api/v2/users/4/","username":"jdoe","email":"jdoe@example.com",` +
+				`"first_name":"","last_name":"","is_superuser":false,"password":"$encrypted$"}`),
+			expected: UserResourceModel{
+				Id:          types.Int64Value(4),
+				Url:         types.StringValue("/api/v2/users/4/"),
+				Username:    types.StringValue("jdoe"),
+				Email:       types.StringValue("jdoe@example.com"),
+				FirstName:   types.StringNull(),
+				LastName:    types.StringNull(),
+				IsSuperuser: types.BoolValue(false),
+				Password:    types.StringValue("s3cret!"),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := UserResourceModel{Password: types.StringValue("s3cret!")}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, resource)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func TestAccUserResource(t *testing.T) {
+	var user UserAPIModel
+	randomName := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResource(randomName, "First"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserResourceExists("aap_user.test", &user),
+					resource.TestCheckResourceAttr("aap_user.test", "username", randomName),
+					resource.TestCheckResourceAttr("aap_user.test", "first_name", "First"),
+					resource.TestCheckResourceAttr("aap_user.test", "is_superuser", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserResource(randomName, "Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserResourceExists("aap_user.test", &user),
+					resource.TestCheckResourceAttr("aap_user.test", "first_name", "Updated"),
+				),
+			},
+			// Import by username testing
+			{
+				ResourceName:            "aap_user.test",
+				ImportState:             true,
+				ImportStateId:           randomName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+		CheckDestroy: testAccCheckUserResourceDestroy,
+	})
+}
+
+// testAccUserResource returns a configuration for an AAP User with the provided first name.
+func testAccUserResource(username string, firstName string) string {
+	return fmt.Sprintf(`
+resource "aap_user" "test" {
+  username   = "%s"
+  first_name = "%s"
+  email      = "%[1]s@example.com"
+  password   = "Terraform-test-123!"
+}`, username, firstName)
+}
+
+// testAccCheckUserResourceExists queries the AAP API and retrieves the matching user.
+func testAccCheckUserResourceExists(name string, user *UserAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		userResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("user (%s) not found in state", name)
+		}
+
+		userResponseBody, err := testGetResource(userResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(userResponseBody, &user)
+		if err != nil {
+			return err
+		}
+
+		if user.Id == 0 {
+			return fmt.Errorf("user (%s) not found in AAP", userResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckUserResourceDestroy verifies the user has been destroyed.
+func testAccCheckUserResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_user" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("user (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}
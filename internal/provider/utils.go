@@ -1,18 +1,22 @@
 package provider
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"reflect"
 	"slices"
+	"strings"
 
 	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
 )
 
 func IsValueProvided(value attr.Value) bool {
@@ -67,6 +71,14 @@ func ParseStringValue(description string) types.String {
 	}
 }
 
+// ParseStringPointerValue returns a null value for nil or empty strings returned by AAP.
+func ParseStringPointerValue(value *string) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return ParseStringValue(*value)
+}
+
 func ParseNormalizedValue(variables string) jsontypes.Normalized {
 	if variables != "" {
 		return jsontypes.NewNormalizedValue(variables)
@@ -82,3 +94,299 @@ func ParseAAPCustomStringValue(variables string) customtypes.AAPCustomStringValu
 		return customtypes.NewAAPCustomStringNull()
 	}
 }
+
+// listAPIModel maps a page of results returned by an AAP list endpoint.
+type listAPIModel struct {
+	Count   int64             `json:"count"`
+	Next    string            `json:"next"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// getAllResults follows the pagination of an AAP list endpoint and returns the results of all the pages.
+func getAllResults(client ProviderHTTPClient, path string) ([]json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var results []json.RawMessage
+
+	for path != "" {
+		body, diagsGet := client.Get(path)
+		diags.Append(diagsGet...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var page listAPIModel
+		err := json.Unmarshal(body, &page)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, diags
+		}
+
+		results = append(results, page.Results...)
+		path = page.Next
+	}
+
+	return results, diags
+}
+
+// getResourceByName returns the single resource with the provided name from an AAP list endpoint.
+func getResourceByName(client ProviderHTTPClient, listPath string, name string) ([]byte, diag.Diagnostics) {
+	return getResourceByField(client, listPath, "name", name)
+}
+
+// getResourceByField returns the single resource with the provided field value from an AAP list endpoint.
+func getResourceByField(client ProviderHTTPClient, listPath string, field string, value string) ([]byte, diag.Diagnostics) {
+	query := url.Values{field: []string{value}}
+	return getResourceByQuery(client, listPath, query, fmt.Sprintf("%s %q", field, value))
+}
+
+// getResourceByOrganizationAndName returns the single resource with the provided name in the organization with the
+// provided name from an AAP list endpoint.
+func getResourceByOrganizationAndName(client ProviderHTTPClient, listPath string, organization string, name string) ([]byte, diag.Diagnostics) {
+	query := url.Values{"organization__name": []string{organization}, "name": []string{name}}
+	return getResourceByQuery(client, listPath, query, fmt.Sprintf("name %q in organization %q", name, organization))
+}
+
+// getResourceByQuery returns the single resource matching the query from an AAP list endpoint, the description of
+// the query is used in the error when no resource, or several, match.
+func getResourceByQuery(client ProviderHTTPClient, listPath string, query url.Values, description string) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	results, diagsList := getAllResults(client, listPath+"?"+query.Encode())
+	diags.Append(diagsList...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(results) != 1 {
+		diags.AddError(
+			"Unexpected number of results",
+			fmt.Sprintf("Expected exactly one resource with %s from %s, found %d", description, listPath, len(results)),
+		)
+		return nil, diags
+	}
+
+	return results[0], diags
+}
+
+// readAssociatedIDs returns the ids of the resources associated at the provided URL, in the order returned by AAP.
+func readAssociatedIDs(client ProviderHTTPClient, url string) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	results, diagsList := getAllResults(client, url)
+	diags.Append(diagsList...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := make([]int64, 0, len(results))
+	for _, result := range results {
+		var item struct {
+			ID int64 `json:"id"`
+		}
+		err := json.Unmarshal(result, &item)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, diags
+		}
+		ids = append(ids, item.ID)
+	}
+
+	return ids, diags
+}
+
+// associateIDs associates, or disassociates, the resources with the provided ids at the provided URL.
+// Requests are sent one at a time so that AAP records the associations in the order of the ids.
+func associateIDs(client ProviderHTTPClient, url string, ids []int64, disassociate bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, id := range ids {
+		body := map[string]interface{}{"id": id}
+		if disassociate {
+			body["disassociate"] = true
+		}
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			diags.AddError("Body JSON Marshal Error", err.Error())
+			return diags
+		}
+
+		resp, respBody, err := client.doRequest(http.MethodPost, url, bytes.NewReader(jsonBody))
+		diags.Append(ValidateResponse(resp, respBody, err, []int{http.StatusNoContent})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// orderedAssociationChanges returns the ids to disassociate and then to associate, in order, so that the associated
+// ids match the expected ones. Associations after the longest common prefix are removed and added again, since AAP
+// appends new associations at the end.
+func orderedAssociationChanges(current []int64, expected []int64) ([]int64, []int64) {
+	prefix := 0
+	for prefix < len(current) && prefix < len(expected) && current[prefix] == expected[prefix] {
+		prefix++
+	}
+
+	return current[prefix:], expected[prefix:]
+}
+
+// reconcileOrderedAssociation updates the resources associated at the provided URL to match the expected ids, in order.
+func reconcileOrderedAssociation(client ProviderHTTPClient, url string, expected []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, diagsRead := readAssociatedIDs(client, url)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	toRemove, toAdd := orderedAssociationChanges(current, expected)
+
+	diags.Append(associateIDs(client, url, toRemove, true)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(associateIDs(client, url, toAdd, false)...)
+	return diags
+}
+
+// reconcileAssociation updates the resources associated at the provided URL to match the expected ids, regardless
+// of their order.
+func reconcileAssociation(client ProviderHTTPClient, url string, expected []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, diagsRead := readAssociatedIDs(client, url)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(associateIDs(client, url, sliceDifference(expected, current), false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(associateIDs(client, url, sliceDifference(current, expected), true)...)
+	return diags
+}
+
+// decodeJSONOrYAML decodes a JSON or YAML document into the values produced when decoding the equivalent JSON.
+// An empty document is decoded as an empty object.
+func decodeJSONOrYAML(value string) (interface{}, error) {
+	var decoded interface{} = map[string]interface{}{}
+	err := yaml.Unmarshal([]byte(value), &decoded)
+	if err != nil {
+		return nil, err
+	}
+	if decoded == nil {
+		decoded = map[string]interface{}{}
+	}
+
+	// Normalize the decoded values to the ones produced when decoding JSON
+	jsonValue, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	_ = json.Unmarshal(jsonValue, &normalized)
+
+	return normalized, nil
+}
+
+// parseJSONObjectValue returns the current value when it is equivalent to the JSON object returned by AAP,
+// or the compact JSON representation of the object returned by AAP.
+func parseJSONObjectValue(current customtypes.AAPCustomStringValue, apiValue json.RawMessage) customtypes.AAPCustomStringValue {
+	var apiObject interface{}
+	_ = json.Unmarshal(apiValue, &apiObject)
+	if object, ok := apiObject.(map[string]interface{}); apiObject == nil || (ok && len(object) == 0) {
+		// AAP returns an empty object when no value is provided
+		if current.IsNull() || current.IsUnknown() {
+			return customtypes.NewAAPCustomStringNull()
+		}
+		apiObject = map[string]interface{}{}
+	}
+
+	if !current.IsNull() && !current.IsUnknown() {
+		currentObject, err := decodeJSONOrYAML(current.ValueString())
+		if err == nil && reflect.DeepEqual(currentObject, apiObject) {
+			return current
+		}
+	}
+
+	compact, _ := json.Marshal(apiObject)
+	return customtypes.NewAAPCustomStringValue(string(compact))
+}
+
+// cutLast slices value around the last instance of separator.
+func cutLast(value string, separator string) (string, string, bool) {
+	index := strings.LastIndex(value, separator)
+	if index < 0 {
+		return value, "", false
+	}
+	return value[:index], value[index+len(separator):], true
+}
+
+// parseOrganizationImportID splits an import identifier made of the organization name and the resource name,
+// separated by a slash. The resource name is the part after the last slash.
+func parseOrganizationImportID(id string, resourceType string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	organization, name, found := cutLast(id, "/")
+	if !found || organization == "" || name == "" {
+		diags.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the organization name and the %s name separated by a slash, got: %s", resourceType, id),
+		)
+		return "", "", diags
+	}
+
+	return organization, name, diags
+}
+
+// launchUpdateAndWait launches the update of a project or an inventory source and waits for it to succeed.
+// The updateType is the type of the launched update job, either project_update or inventory_update.
+func launchUpdateAndWait(client ProviderHTTPClient, resourceURL string, updateType string, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(resourceURL, "update")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	resp, body, err := client.doRequest(http.MethodPost, url, nil)
+	diags.Append(ValidateResponse(resp, body, err, []int{http.StatusAccepted})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var launched map[string]json.RawMessage
+	var updateID int64
+	err = json.Unmarshal(body, &launched)
+	if err == nil {
+		err = json.Unmarshal(launched[updateType], &updateID)
+	}
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	updateURL := fmt.Sprintf("/api/v2/%ss/%d/", updateType, updateID)
+	status, diagsWait := waitForJob(client, updateURL, jobWaitTimeout)
+	diags.Append(diagsWait...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if status != jobStatusSuccessful {
+		diags.AddError(
+			fmt.Sprintf("%s did not succeed", name),
+			fmt.Sprintf("%s %s finished with status %s", name, updateURL, status),
+		)
+	}
+
+	return diags
+}
@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -80,3 +83,115 @@ func TestParseNormalizedValue(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderedAssociationChanges(t *testing.T) {
+	tests := []struct {
+		description      string
+		current          []int64
+		expected         []int64
+		expectedToRemove []int64
+		expectedToAdd    []int64
+	}{
+		{"Test no changes", []int64{1, 2, 3}, []int64{1, 2, 3}, []int64{}, []int64{}},
+		{"Test append", []int64{1, 2}, []int64{1, 2, 3}, []int64{}, []int64{3}},
+		{"Test remove last", []int64{1, 2, 3}, []int64{1, 2}, []int64{3}, []int64{}},
+		{"Test reorder", []int64{1, 2, 3}, []int64{1, 3, 2}, []int64{2, 3}, []int64{3, 2}},
+		{"Test remove first", []int64{1, 2, 3}, []int64{2, 3}, []int64{1, 2, 3}, []int64{2, 3}},
+		{"Test from empty", []int64{}, []int64{4, 5}, []int64{}, []int64{4, 5}},
+		{"Test to empty", []int64{4, 5}, []int64{}, []int64{4, 5}, []int64{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			toRemove, toAdd := orderedAssociationChanges(test.current, test.expected)
+			if !slices.Equal(toRemove, test.expectedToRemove) {
+				t.Errorf("Expected to remove %v, but got %v", test.expectedToRemove, toRemove)
+			}
+			if !slices.Equal(toAdd, test.expectedToAdd) {
+				t.Errorf("Expected to add %v, but got %v", test.expectedToAdd, toAdd)
+			}
+		})
+	}
+}
+
+func TestCutLast(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedBefore string
+		expectedAfter  string
+		expectedFound  bool
+		description    string
+	}{
+		{"team/user", "team", "user", true, "Test single separator"},
+		{"ops/europe/user", "ops/europe", "user", true, "Test separator in first part"},
+		{"team", "team", "", false, "Test no separator"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			before, after, found := cutLast(test.input, "/")
+			if before != test.expectedBefore || after != test.expectedAfter || found != test.expectedFound {
+				t.Errorf("Expected (%s, %s, %t), but got (%s, %s, %t)",
+					test.expectedBefore, test.expectedAfter, test.expectedFound, before, after, found)
+			}
+		})
+	}
+}
+
+func TestParseOrganizationImportID(t *testing.T) {
+	tests := []struct {
+		input                string
+		expectedOrganization string
+		expectedName         string
+		expectedErrors       int
+		description          string
+	}{
+		{"Default/ops", "Default", "ops", 0, "Test organization and name"},
+		{"ops/europe/ops", "ops/europe", "ops", 0, "Test separator in organization"},
+		{"ops", "", "", 1, "Test no separator"},
+		{"/ops", "", "", 1, "Test empty organization"},
+		{"Default/", "", "", 1, "Test empty name"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			organization, name, diags := parseOrganizationImportID(test.input, "team")
+			if organization != test.expectedOrganization || name != test.expectedName || diags.ErrorsCount() != test.expectedErrors {
+				t.Errorf("Expected (%s, %s, %d errors), but got (%s, %s, %d errors)",
+					test.expectedOrganization, test.expectedName, test.expectedErrors, organization, name, diags.ErrorsCount())
+			}
+		})
+	}
+}
+
+func TestLaunchUpdateAndWait(t *testing.T) {
+	tests := []struct {
+		status         string
+		expectedErrors []string
+		description    string
+	}{
+		{"successful", nil, "Test successful update"},
+		{"failed", []string{"Inventory update did not succeed"}, "Test failed update"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			client := NewMockRawHTTPClient(map[string]MockRawResponse{
+				"POST /api/v2/inventory_sources/3/update": {StatusCode: http.StatusAccepted, Body: `{"inventory_update":9}`},
+				"GET /api/v2/inventory_updates/9/": {
+					StatusCode: http.StatusOK,
+					Body:       fmt.Sprintf(`{"id":9,"status":"%s"}`, test.status),
+				},
+			})
+
+			diags := launchUpdateAndWait(client, "/api/v2/inventory_sources/3/", "inventory_update", "Inventory update")
+			var errors []string
+			for _, err := range diags.Errors() {
+				errors = append(errors, err.Summary())
+			}
+			if !slices.Equal(errors, test.expectedErrors) {
+				t.Errorf("Expected errors %v, but got %v", test.expectedErrors, errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const workflowApprovalStatusPending = "pending"
+
+// Statuses reported by AAP once a workflow approval is approved or denied, by action.
+var workflowApprovalActionStatuses = map[string]string{
+	"approve": jobStatusSuccessful,
+	"deny":    jobStatusFailed,
+}
+
+// Workflow approval AAP API model
+type WorkflowApprovalAPIModel struct {
+	Id     int64  `json:"id"`
+	Url    string `json:"url"`
+	Status string `json:"status"`
+}
+
+// Workflow job node AAP API model
+type WorkflowJobNodeAPIModel struct {
+	Id            int64  `json:"id"`
+	Identifier    string `json:"identifier"`
+	Job           *int64 `json:"job"`
+	DoNotRun      bool   `json:"do_not_run"`
+	SummaryFields struct {
+		UnifiedJobTemplate struct {
+			UnifiedJobType string `json:"unified_job_type"`
+		} `json:"unified_job_template"`
+	} `json:"summary_fields"`
+}
+
+// WorkflowApprovalResourceModel maps the workflow approval resource schema to a Go struct.
+type WorkflowApprovalResourceModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	Url             types.String `tfsdk:"url"`
+	WorkflowJobId   types.Int64  `tfsdk:"workflow_job_id"`
+	NodeIdentifier  types.String `tfsdk:"node_identifier"`
+	Action          types.String `tfsdk:"action"`
+	WaitForApproval types.Bool   `tfsdk:"wait_for_approval"`
+	Status          types.String `tfsdk:"status"`
+}
+
+// WorkflowApprovalResource is the resource implementation.
+type WorkflowApprovalResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &WorkflowApprovalResource{}
+	_ resource.ResourceWithConfigure = &WorkflowApprovalResource{}
+)
+
+// NewWorkflowApprovalResource is a helper function to simplify the provider implementation.
+func NewWorkflowApprovalResource() resource.Resource {
+	return &WorkflowApprovalResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WorkflowApprovalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_approval"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WorkflowApprovalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the workflow approval resource.
+func (r *WorkflowApprovalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Id of the workflow approval",
+			},
+			"url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "URL of the workflow approval",
+			},
+			"workflow_job_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "Id of the workflow job waiting for the approval.",
+			},
+			"node_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Identifier of the approval node of the workflow job template the workflow job was launched from.",
+			},
+			"action": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  []validator.String{stringvalidator.OneOf("approve", "deny")},
+				Description: "Action taken on the workflow approval, either approve or deny.",
+			},
+			"wait_for_approval": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "When true, wait for the workflow job to reach the approval node. Otherwise, fail when the " +
+					"workflow approval is not pending yet. Defaults to false.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the workflow approval, successful once approved and failed once denied or timed out.",
+			},
+		},
+	}
+}
+
+// Create approves or denies the workflow approval and sets the Terraform state on success.
+func (r *WorkflowApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowApprovalResourceModel
+
+	// Read Terraform plan data into workflow approval resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find the workflow approval of the node and take the action on it
+	approvalID, diags := findWorkflowApproval(r.client, data.WorkflowJobId.ValueInt64(), data.NodeIdentifier.ValueString(),
+		data.WaitForApproval.ValueBool(), jobWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Url = types.StringValue(fmt.Sprintf("/api/v2/workflow_approvals/%d/", approvalID))
+
+	resp.Diagnostics.Append(r.ResolveWorkflowApproval(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest workflow approval data.
+func (r *WorkflowApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowApprovalResourceModel
+
+	// Read current Terraform state data into workflow approval resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest workflow approval data from AAP, the approval is removed along with its workflow job
+	readResponse, readResponseBody, err := r.client.doRequest(http.MethodGet, data.Url.ValueString(), nil)
+	if readResponse != nil && readResponse.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(ValidateResponse(readResponse, readResponseBody, err, []int{http.StatusOK})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest workflow approval data into workflow approval resource model
+	resp.Diagnostics.Append(data.ParseHttpResponse(readResponseBody)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update keeps the current workflow approval, only wait_for_approval can change without replacing the resource.
+func (r *WorkflowApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state WorkflowApprovalResourceModel
+
+	// Read Terraform plan and state data into workflow approval resource models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = state.Id
+	data.Url = state.Url
+	data.Status = state.Status
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete does nothing, an approved or denied workflow approval cannot be reverted.
+func (r *WorkflowApprovalResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ResolveWorkflowApproval approves or denies the pending workflow approval, and saves the resulting workflow approval
+// into the workflow approval resource model. A workflow approval already resolved with the expected action is kept.
+func (r *WorkflowApprovalResource) ResolveWorkflowApproval(data *WorkflowApprovalResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	readResponseBody, diagsGet := r.client.Get(data.Url.ValueString())
+	diags.Append(diagsGet...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(data.ParseHttpResponse(readResponseBody)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	action := data.Action.ValueString()
+	if data.Status.ValueString() != workflowApprovalStatusPending {
+		if data.Status.ValueString() != workflowApprovalActionStatuses[action] {
+			diags.AddError(
+				"Workflow approval is not pending",
+				fmt.Sprintf("Cannot %s the workflow approval %s, its status is %s", action, data.Url.ValueString(),
+					data.Status.ValueString()),
+			)
+		}
+		return diags
+	}
+
+	actionURL, diagsURL := getURL(data.Url.ValueString(), action)
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	actionResponse, actionResponseBody, err := r.client.doRequest(http.MethodPost, actionURL, nil)
+	diags.Append(ValidateResponse(actionResponse, actionResponseBody, err, []int{http.StatusNoContent})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	readResponseBody, diagsGet = r.client.Get(data.Url.ValueString())
+	diags.Append(diagsGet...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(data.ParseHttpResponse(readResponseBody)...)
+	return diags
+}
+
+// ParseHttpResponse updates the workflow approval resource data from an AAP API response
+func (r *WorkflowApprovalResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiWorkflowApproval WorkflowApprovalAPIModel
+	err := json.Unmarshal(body, &apiWorkflowApproval)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the workflow approval resource schema and update attribute values
+	r.Id = types.Int64Value(apiWorkflowApproval.Id)
+	r.Url = types.StringValue(apiWorkflowApproval.Url)
+	r.Status = types.StringValue(apiWorkflowApproval.Status)
+
+	return diags
+}
+
+// findWorkflowApproval returns the id of the workflow approval spawned by the node with the provided identifier of the
+// workflow job. When wait is true, the workflow job is polled until it reaches the node or the timeout expires.
+func findWorkflowApproval(client ProviderHTTPClient, workflowJobID int64, identifier string, wait bool,
+	timeout time.Duration) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	deadline := time.Now().Add(timeout)
+	nodesURL := fmt.Sprintf("/api/v2/workflow_jobs/%d/workflow_nodes/", workflowJobID)
+
+	for {
+		nodeResponseBody, diagsNode := getResourceByField(client, nodesURL, "identifier", identifier)
+		diags.Append(diagsNode...)
+		if diags.HasError() {
+			return 0, diags
+		}
+
+		var node WorkflowJobNodeAPIModel
+		err := json.Unmarshal(nodeResponseBody, &node)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return 0, diags
+		}
+
+		approvalID, found, diagsApproval := node.WorkflowApprovalID(workflowJobID)
+		diags.Append(diagsApproval...)
+		if diags.HasError() || found {
+			return approvalID, diags
+		}
+
+		if !wait {
+			diags.AddError(
+				"Workflow approval not found",
+				fmt.Sprintf("The workflow job %d has not reached the approval node %q yet", workflowJobID, identifier),
+			)
+			return 0, diags
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				"Timeout waiting for workflow approval",
+				fmt.Sprintf("The workflow job %d did not reach the approval node %q within %s", workflowJobID, identifier, timeout),
+			)
+			return 0, diags
+		}
+
+		time.Sleep(jobPollInterval)
+	}
+}
+
+// WorkflowApprovalID returns the id of the workflow approval spawned by the workflow job node, and whether the node
+// has already spawned it.
+func (n *WorkflowJobNodeAPIModel) WorkflowApprovalID(workflowJobID int64) (int64, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n.SummaryFields.UnifiedJobTemplate.UnifiedJobType != workflowApprovalJobType {
+		diags.AddError(
+			"Unexpected workflow node",
+			fmt.Sprintf("The node %q of the workflow job %d is not an approval node", n.Identifier, workflowJobID),
+		)
+		return 0, false, diags
+	}
+
+	if n.Job != nil {
+		return *n.Job, true, diags
+	}
+
+	if n.DoNotRun {
+		diags.AddError(
+			"Workflow approval not found",
+			fmt.Sprintf("The workflow job %d will not run the approval node %q", workflowJobID, n.Identifier),
+		)
+	}
+	return 0, false, diags
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestWorkflowApprovalResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the WorkflowApprovalResource and call its Schema method
+	NewWorkflowApprovalResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestWorkflowApprovalResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected WorkflowApprovalResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: WorkflowApprovalResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name:  "approved workflow approval",
+			input: []byte(`{"id":12,"url":"/api/v2/workflow_approvals/12/","status":"successful","timed_out":false}`),
+			expected: WorkflowApprovalResourceModel{
+				Id:     types.Int64Value(12),
+				Url:    types.StringValue("/api/v2/workflow_approvals/12/"),
+				Status: types.StringValue("successful"),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := WorkflowApprovalResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobNodeWorkflowApprovalID(t *testing.T) {
+	var testTable = []struct {
+		name          string
+		input         string
+		expectedID    int64
+		expectedFound bool
+		expected      []string
+	}{
+		{
+			name: "pending approval",
+			input: `{"id":3,"identifier":"approve","job":12,"do_not_run":false,` +
+				`"summary_fields":{"unified_job_template":{"unified_job_type":"workflow_approval"}}}`,
+			expectedID:    12,
+			expectedFound: true,
+			expected:      nil,
+		},
+		{
+			name: "approval not reached yet",
+			input: `{"id":3,"identifier":"approve","job":null,"do_not_run":false,` +
+				`"summary_fields":{"unified_job_template":{"unified_job_type":"workflow_approval"}}}`,
+			expected: nil,
+		},
+		{
+			name: "approval not run",
+			input: `{"id":3,"identifier":"approve","job":null,"do_not_run":true,` +
+				`"summary_fields":{"unified_job_template":{"unified_job_type":"workflow_approval"}}}`,
+			expected: []string{"Workflow approval not found"},
+		},
+		{
+			name: "job node",
+			input: `{"id":4,"identifier":"deploy","job":15,"do_not_run":false,` +
+				`"summary_fields":{"unified_job_template":{"unified_job_type":"job"}}}`,
+			expected: []string{"Unexpected workflow node"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			var node WorkflowJobNodeAPIModel
+			if err := json.Unmarshal([]byte(test.input), &node); err != nil {
+				t.Fatal(err)
+			}
+
+			actualID, actualFound, diags := node.WorkflowApprovalID(7)
+			var actual []string
+			for _, err := range diags.Errors() {
+				actual = append(actual, err.Summary())
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected errors (%v) not equal to actual (%v)", test.expected, actual)
+			}
+			if actualID != test.expectedID || actualFound != test.expectedFound {
+				t.Errorf("Expected (%d, %t) not equal to actual (%d, %t)", test.expectedID, test.expectedFound, actualID, actualFound)
+			}
+		})
+	}
+}
+
+// Acceptance tests
+
+func testAccWorkflowApprovalResourcePreCheck(t *testing.T) {
+	// ensure provider requirements
+	testAccPreCheck(t)
+
+	requiredAAPWorkflowApprovalEnvVars := []string{
+		"AAP_TEST_WORKFLOW_JOB_ID",
+		"AAP_TEST_WORKFLOW_APPROVAL_NODE",
+	}
+
+	for _, key := range requiredAAPWorkflowApprovalEnvVars {
+		if v := os.Getenv(key); v == "" {
+			t.Fatalf("'%s' environment variable must be set when running acceptance tests for workflow approval resource", key)
+		}
+	}
+}
+
+func TestAccWorkflowApprovalResource(t *testing.T) {
+	workflowJobID := os.Getenv("AAP_TEST_WORKFLOW_JOB_ID")
+	nodeIdentifier := os.Getenv("AAP_TEST_WORKFLOW_APPROVAL_NODE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccWorkflowApprovalResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowApprovalResource(workflowJobID, nodeIdentifier),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowApprovalResourceStatus("aap_workflow_approval.test", jobStatusSuccessful),
+					resource.TestCheckResourceAttr("aap_workflow_approval.test", "status", jobStatusSuccessful),
+					resource.TestCheckResourceAttrSet("aap_workflow_approval.test", "url"),
+				),
+			},
+		},
+	})
+}
+
+// testAccWorkflowApprovalResource returns a configuration approving the workflow approval of the provided node.
+func testAccWorkflowApprovalResource(workflowJobID string, nodeIdentifier string) string {
+	return fmt.Sprintf(`
+resource "aap_workflow_approval" "test" {
+  workflow_job_id   = %s
+  node_identifier   = "%s"
+  action            = "approve"
+  wait_for_approval = true
+}`, workflowJobID, nodeIdentifier)
+}
+
+// testAccCheckWorkflowApprovalResourceStatus queries the AAP API and checks the status of the workflow approval.
+func testAccCheckWorkflowApprovalResourceStatus(name string, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		workflowApprovalResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("workflow approval (%s) not found in state", name)
+		}
+
+		workflowApprovalResponseBody, err := testGetResource(workflowApprovalResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		var workflowApproval WorkflowApprovalAPIModel
+		err = json.Unmarshal(workflowApprovalResponseBody, &workflowApproval)
+		if err != nil {
+			return err
+		}
+
+		if workflowApproval.Status != status {
+			return fmt.Errorf("workflow approval (%s) has status %s, expected %s", workflowApproval.Url, workflowApproval.Status, status)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,1299 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowAskOnLaunchFlags maps the fields of a workflow job template to the flags prompting for them on launch.
+var workflowAskOnLaunchFlags = map[string]string{
+	"extra_vars": "ask_variables_on_launch",
+	"inventory":  "ask_inventory_on_launch",
+	"job_tags":   "ask_tags_on_launch",
+	"labels":     "ask_labels_on_launch",
+	"limit":      "ask_limit_on_launch",
+	"scm_branch": "ask_scm_branch_on_launch",
+	"skip_tags":  "ask_skip_tags_on_launch",
+}
+
+// workflowNodeEdges are the kinds of edges between workflow nodes, the children being run depending on the result
+// of their parent node.
+var workflowNodeEdges = []string{"success_nodes", "failure_nodes", "always_nodes"}
+
+// workflowApprovalJobType is the unified job type of the templates run by the approval nodes.
+const workflowApprovalJobType = "workflow_approval"
+
+// Workflow job template AAP API model
+type WorkflowJobTemplateAPIModel struct {
+	Id                int64  `json:"id,omitempty"`
+	Url               string `json:"url,omitempty"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	Organization      *int64 `json:"organization"`
+	Inventory         *int64 `json:"inventory"`
+	Limit             string `json:"limit"`
+	ScmBranch         string `json:"scm_branch"`
+	JobTags           string `json:"job_tags"`
+	SkipTags          string `json:"skip_tags"`
+	ExtraVars         string `json:"extra_vars"`
+	AllowSimultaneous bool   `json:"allow_simultaneous"`
+
+	AskVariablesOnLaunch bool `json:"ask_variables_on_launch"`
+	AskInventoryOnLaunch bool `json:"ask_inventory_on_launch"`
+	AskTagsOnLaunch      bool `json:"ask_tags_on_launch"`
+	AskLabelsOnLaunch    bool `json:"ask_labels_on_launch"`
+	AskLimitOnLaunch     bool `json:"ask_limit_on_launch"`
+	AskScmBranchOnLaunch bool `json:"ask_scm_branch_on_launch"`
+	AskSkipTagsOnLaunch  bool `json:"ask_skip_tags_on_launch"`
+}
+
+// Workflow job template node AAP API model
+type WorkflowJobTemplateNodeAPIModel struct {
+	Identifier             string          `json:"identifier"`
+	UnifiedJobTemplate     *int64          `json:"unified_job_template"`
+	AllParentsMustConverge bool            `json:"all_parents_must_converge"`
+	ExtraData              json.RawMessage `json:"extra_data"`
+	Inventory              *int64          `json:"inventory"`
+	ExecutionEnvironment   *int64          `json:"execution_environment"`
+	ScmBranch              *string         `json:"scm_branch"`
+	JobType                *string         `json:"job_type"`
+	JobTags                *string         `json:"job_tags"`
+	SkipTags               *string         `json:"skip_tags"`
+	Limit                  *string         `json:"limit"`
+	DiffMode               *bool           `json:"diff_mode"`
+	Verbosity              *int64          `json:"verbosity"`
+	Forks                  *int64          `json:"forks"`
+	JobSliceCount          *int64          `json:"job_slice_count"`
+	Timeout                *int64          `json:"timeout"`
+}
+
+// workflowJobTemplateNodeResponseAPIModel adds the workflow node data only returned by AAP to the node API model.
+type workflowJobTemplateNodeResponseAPIModel struct {
+	WorkflowJobTemplateNodeAPIModel
+	Id            int64   `json:"id"`
+	Url           string  `json:"url"`
+	SuccessNodes  []int64 `json:"success_nodes"`
+	FailureNodes  []int64 `json:"failure_nodes"`
+	AlwaysNodes   []int64 `json:"always_nodes"`
+	SummaryFields struct {
+		UnifiedJobTemplate struct {
+			UnifiedJobType string `json:"unified_job_type"`
+		} `json:"unified_job_template"`
+	} `json:"summary_fields"`
+}
+
+// Workflow approval template AAP API model
+type WorkflowApprovalTemplateAPIModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Timeout     int64  `json:"timeout"`
+}
+
+// WorkflowJobTemplateResourceModel maps the workflow job template resource schema to a Go struct.
+type WorkflowJobTemplateResourceModel struct {
+	Id                types.Int64                      `tfsdk:"id"`
+	Url               types.String                     `tfsdk:"url"`
+	Name              types.String                     `tfsdk:"name"`
+	Description       types.String                     `tfsdk:"description"`
+	Organization      types.Int64                      `tfsdk:"organization"`
+	Inventory         types.Int64                      `tfsdk:"inventory"`
+	Limit             types.String                     `tfsdk:"limit"`
+	ScmBranch         types.String                     `tfsdk:"scm_branch"`
+	JobTags           types.String                     `tfsdk:"job_tags"`
+	SkipTags          types.String                     `tfsdk:"skip_tags"`
+	ExtraVars         customtypes.AAPCustomStringValue `tfsdk:"extra_vars"`
+	AllowSimultaneous types.Bool                       `tfsdk:"allow_simultaneous"`
+	Labels            types.Set                        `tfsdk:"labels"`
+	Nodes             []WorkflowJobTemplateNodeModel   `tfsdk:"node"`
+
+	AskVariablesOnLaunch types.Bool `tfsdk:"ask_variables_on_launch"`
+	AskInventoryOnLaunch types.Bool `tfsdk:"ask_inventory_on_launch"`
+	AskTagsOnLaunch      types.Bool `tfsdk:"ask_tags_on_launch"`
+	AskLabelsOnLaunch    types.Bool `tfsdk:"ask_labels_on_launch"`
+	AskLimitOnLaunch     types.Bool `tfsdk:"ask_limit_on_launch"`
+	AskScmBranchOnLaunch types.Bool `tfsdk:"ask_scm_branch_on_launch"`
+	AskSkipTagsOnLaunch  types.Bool `tfsdk:"ask_skip_tags_on_launch"`
+}
+
+// WorkflowJobTemplateNodeModel maps a node of the workflow job template resource schema to a Go struct.
+type WorkflowJobTemplateNodeModel struct {
+	Identifier             types.String                     `tfsdk:"identifier"`
+	UnifiedJobTemplate     types.Int64                      `tfsdk:"unified_job_template"`
+	Approval               *WorkflowApprovalModel           `tfsdk:"approval"`
+	SuccessNodes           types.Set                        `tfsdk:"success_nodes"`
+	FailureNodes           types.Set                        `tfsdk:"failure_nodes"`
+	AlwaysNodes            types.Set                        `tfsdk:"always_nodes"`
+	AllParentsMustConverge types.Bool                       `tfsdk:"all_parents_must_converge"`
+	ExtraData              customtypes.AAPCustomStringValue `tfsdk:"extra_data"`
+	Inventory              types.Int64                      `tfsdk:"inventory"`
+	ExecutionEnvironment   types.Int64                      `tfsdk:"execution_environment"`
+	ScmBranch              types.String                     `tfsdk:"scm_branch"`
+	JobType                types.String                     `tfsdk:"job_type"`
+	JobTags                types.String                     `tfsdk:"job_tags"`
+	SkipTags               types.String                     `tfsdk:"skip_tags"`
+	Limit                  types.String                     `tfsdk:"limit"`
+	DiffMode               types.Bool                       `tfsdk:"diff_mode"`
+	Verbosity              types.Int64                      `tfsdk:"verbosity"`
+	Forks                  types.Int64                      `tfsdk:"forks"`
+	JobSliceCount          types.Int64                      `tfsdk:"job_slice_count"`
+	Timeout                types.Int64                      `tfsdk:"timeout"`
+}
+
+// WorkflowApprovalModel maps the approval of a workflow node to a Go struct.
+type WorkflowApprovalModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+// WorkflowJobTemplateResource is the resource implementation.
+type WorkflowJobTemplateResource struct {
+	client ProviderHTTPClient
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &WorkflowJobTemplateResource{}
+	_ resource.ResourceWithConfigure      = &WorkflowJobTemplateResource{}
+	_ resource.ResourceWithValidateConfig = &WorkflowJobTemplateResource{}
+	_ resource.ResourceWithImportState    = &WorkflowJobTemplateResource{}
+)
+
+// NewWorkflowJobTemplateResource is a helper function to simplify the provider implementation.
+func NewWorkflowJobTemplateResource() resource.Resource {
+	return &WorkflowJobTemplateResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WorkflowJobTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_job_template"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WorkflowJobTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AAPClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AAPClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the workflow job template resource.
+func (r *WorkflowJobTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Description: "Workflow job template id",
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Description: "URL of the workflow job template",
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the workflow job template",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: "Description for the workflow job template",
+		},
+		"organization": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Identifier for the organization the workflow job template belongs to.",
+		},
+		"inventory": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Identifier for the inventory applied to the nodes of the workflow which prompt for an inventory.",
+		},
+		"limit": schema.StringAttribute{
+			Optional:    true,
+			Description: "Host pattern applied to the nodes of the workflow which prompt for a limit.",
+		},
+		"scm_branch": schema.StringAttribute{
+			Optional:    true,
+			Description: "Source control branch applied to the nodes of the workflow which prompt for a branch.",
+		},
+		"job_tags": schema.StringAttribute{
+			Optional:    true,
+			Description: "Comma separated playbook tags applied to the nodes of the workflow which prompt for tags.",
+		},
+		"skip_tags": schema.StringAttribute{
+			Optional:    true,
+			Description: "Comma separated playbook tags to skip applied to the nodes of the workflow which prompt for skip tags.",
+		},
+		"extra_vars": schema.StringAttribute{
+			Optional:    true,
+			CustomType:  customtypes.AAPCustomStringType{},
+			Description: "Extra variables passed to the jobs of the workflow. Must be provided as either a JSON or YAML string.",
+		},
+		"allow_simultaneous": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Allow jobs from the workflow job template to run concurrently. Defaults to false.",
+		},
+		"labels": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+			Description: "Set of label ids of the workflow job template. If not provided, the labels of the workflow job " +
+				"template are left untouched. AAP deletes the labels no longer used by any resource.",
+		},
+	}
+
+	for field, flag := range workflowAskOnLaunchFlags {
+		attributes[flag] = schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: fmt.Sprintf("Prompt for %s when launching a workflow job from the workflow job template. Defaults to false.", strings.ReplaceAll(field, "_", " ")),
+		}
+	}
+
+	edgeAttribute := func(result string) schema.SetAttribute {
+		return schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: fmt.Sprintf("Identifiers of the nodes run when the node %s.", result),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"node": schema.ListNestedBlock{
+				Description: "Nodes of the workflow graph. The nodes of the workflow job template in AAP are matched to these " +
+					"nodes by identifier, and only the nodes and edges which differ are changed.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"identifier": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+							Description: "Identifier of the node, unique within the workflow job template.",
+						},
+						"unified_job_template": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("approval")),
+							},
+							Description: "Identifier for the template run by the node: a job template, a workflow job template, " +
+								"a project or an inventory source. Exactly one of unified_job_template or approval must be provided.",
+						},
+						"approval": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Approval requested by the node before the workflow continues.",
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required:    true,
+									Description: "Name of the approval",
+								},
+								"description": schema.StringAttribute{
+									Optional:    true,
+									Description: "Description for the approval",
+								},
+								"timeout": schema.Int64Attribute{
+									Optional:    true,
+									Validators:  []validator.Int64{int64validator.AtLeast(1)},
+									Description: "Number of seconds before the approval times out. The approval never times out if not provided.",
+								},
+							},
+						},
+						"success_nodes": edgeAttribute("succeeds"),
+						"failure_nodes": edgeAttribute("fails"),
+						"always_nodes":  edgeAttribute("completes, whatever its result"),
+						"all_parents_must_converge": schema.BoolAttribute{
+							Optional: true,
+							Description: "Run the node only when all its parent nodes have met their condition to run it, " +
+								"instead of any of them.",
+						},
+						"extra_data": schema.StringAttribute{
+							Optional:    true,
+							CustomType:  customtypes.AAPCustomStringType{},
+							Description: "Extra variables prompted when running the node. Must be provided as either a JSON or YAML string.",
+						},
+						"inventory": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Identifier for the inventory prompted when running the node.",
+						},
+						"execution_environment": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Identifier for the execution environment prompted when running the node.",
+						},
+						"scm_branch": schema.StringAttribute{
+							Optional:    true,
+							Description: "Source control branch prompted when running the node.",
+						},
+						"job_type": schema.StringAttribute{
+							Optional:    true,
+							Validators:  []validator.String{stringvalidator.OneOf("run", "check")},
+							Description: "Job type prompted when running the node, either run or check.",
+						},
+						"job_tags": schema.StringAttribute{
+							Optional:    true,
+							Description: "Comma separated playbook tags prompted when running the node.",
+						},
+						"skip_tags": schema.StringAttribute{
+							Optional:    true,
+							Description: "Comma separated playbook tags to skip prompted when running the node.",
+						},
+						"limit": schema.StringAttribute{
+							Optional:    true,
+							Description: "Host pattern prompted when running the node.",
+						},
+						"diff_mode": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Diff mode prompted when running the node.",
+						},
+						"verbosity": schema.Int64Attribute{
+							Optional:    true,
+							Validators:  []validator.Int64{int64validator.Between(0, maxJobVerbosity)},
+							Description: "Verbosity prompted when running the node, from 0 (normal) to 5 (WinRM debug).",
+						},
+						"forks": schema.Int64Attribute{
+							Optional:    true,
+							Validators:  []validator.Int64{int64validator.AtLeast(0)},
+							Description: "Number of forks prompted when running the node.",
+						},
+						"job_slice_count": schema.Int64Attribute{
+							Optional:    true,
+							Validators:  []validator.Int64{int64validator.AtLeast(1)},
+							Description: "Number of job slices prompted when running the node.",
+						},
+						"timeout": schema.Int64Attribute{
+							Optional:    true,
+							Validators:  []validator.Int64{int64validator.AtLeast(0)},
+							Description: "Timeout in seconds prompted when running the node.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the node identifiers are unique and that the edges only reference declared nodes.
+func (r *WorkflowJobTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorkflowJobTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWorkflowNodes(data.Nodes)...)
+}
+
+// Create creates the workflow job template resource and sets the Terraform state on success.
+func (r *WorkflowJobTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowJobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into workflow job template resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from workflow job template data
+	createRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestData := bytes.NewReader(createRequestBody)
+
+	// Create new workflow job template in AAP
+	createResponseBody, diags := r.client.Create("/api/v2/workflow_job_templates/", requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save new workflow job template data into workflow job template resource model
+	diags = data.ParseHttpResponse(createResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the created workflow job template into Terraform state, so that it is tracked even if handling its nodes
+	// or labels fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleNodes(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest workflow job template data.
+func (r *WorkflowJobTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowJobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into workflow job template resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get latest workflow job template data from AAP
+	readResponseBody, diags := r.client.Get(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save latest workflow job template data into workflow job template resource model
+	diags = data.ParseHttpResponse(readResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadNodes(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the workflow job template resource and sets the updated Terraform state on success.
+func (r *WorkflowJobTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorkflowJobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read Terraform plan data into workflow job template resource model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create request body from workflow job template data
+	updateRequestBody, diags := data.CreateRequestBody()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	requestData := bytes.NewReader(updateRequestBody)
+
+	// Update workflow job template in AAP
+	updateResponseBody, diags := r.client.Update(data.Url.ValueString(), requestData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated workflow job template data into workflow job template resource model
+	diags = data.ParseHttpResponse(updateResponseBody)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleNodes(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.HandleLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the workflow job template resource, along with its nodes.
+func (r *WorkflowJobTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkflowJobTemplateResourceModel
+	var diags diag.Diagnostics
+
+	// Read current Terraform state data into workflow job template resource model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete workflow job template from AAP
+	_, diags = r.client.Delete(data.Url.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ImportState imports an existing workflow job template from its organization name and its name, separated by a slash.
+func (r *WorkflowJobTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data WorkflowJobTemplateResourceModel
+
+	organization, name, diags := parseOrganizationImportID(req.ID, "workflow job template")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get workflow job template data from AAP
+	readResponseBody, diags := getResourceByOrganizationAndName(r.client, "/api/v2/workflow_job_templates/", organization, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save workflow job template data into workflow job template resource model
+	resp.Diagnostics.Append(data.ParseHttpResponse(readResponseBody)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadNodes(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ReadLabels(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save imported data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// CreateRequestBody creates a JSON encoded request body from the workflow job template resource data
+func (r *WorkflowJobTemplateResourceModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert workflow job template resource data to API data model
+	workflowJobTemplate := WorkflowJobTemplateAPIModel{
+		Name:              r.Name.ValueString(),
+		Description:       r.Description.ValueString(),
+		Organization:      r.Organization.ValueInt64Pointer(),
+		Inventory:         r.Inventory.ValueInt64Pointer(),
+		Limit:             r.Limit.ValueString(),
+		ScmBranch:         r.ScmBranch.ValueString(),
+		JobTags:           r.JobTags.ValueString(),
+		SkipTags:          r.SkipTags.ValueString(),
+		ExtraVars:         r.ExtraVars.ValueString(),
+		AllowSimultaneous: r.AllowSimultaneous.ValueBool(),
+
+		AskVariablesOnLaunch: r.AskVariablesOnLaunch.ValueBool(),
+		AskInventoryOnLaunch: r.AskInventoryOnLaunch.ValueBool(),
+		AskTagsOnLaunch:      r.AskTagsOnLaunch.ValueBool(),
+		AskLabelsOnLaunch:    r.AskLabelsOnLaunch.ValueBool(),
+		AskLimitOnLaunch:     r.AskLimitOnLaunch.ValueBool(),
+		AskScmBranchOnLaunch: r.AskScmBranchOnLaunch.ValueBool(),
+		AskSkipTagsOnLaunch:  r.AskSkipTagsOnLaunch.ValueBool(),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(workflowJobTemplate)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for workflow job template resource, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// ParseHttpResponse updates the workflow job template resource data from an AAP API response
+func (r *WorkflowJobTemplateResourceModel) ParseHttpResponse(body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unmarshal the JSON response
+	var apiWorkflowJobTemplate WorkflowJobTemplateAPIModel
+	err := json.Unmarshal(body, &apiWorkflowJobTemplate)
+	if err != nil {
+		diags.AddError("Error parsing JSON response from AAP", err.Error())
+		return diags
+	}
+
+	// Map response to the workflow job template resource schema and update attribute values
+	r.Id = types.Int64Value(apiWorkflowJobTemplate.Id)
+	r.Url = types.StringValue(apiWorkflowJobTemplate.Url)
+	r.Name = types.StringValue(apiWorkflowJobTemplate.Name)
+	r.Description = ParseStringValue(apiWorkflowJobTemplate.Description)
+	r.Organization = types.Int64PointerValue(apiWorkflowJobTemplate.Organization)
+	r.Inventory = types.Int64PointerValue(apiWorkflowJobTemplate.Inventory)
+	r.Limit = ParseStringValue(apiWorkflowJobTemplate.Limit)
+	r.ScmBranch = ParseStringValue(apiWorkflowJobTemplate.ScmBranch)
+	r.JobTags = ParseStringValue(apiWorkflowJobTemplate.JobTags)
+	r.SkipTags = ParseStringValue(apiWorkflowJobTemplate.SkipTags)
+	r.ExtraVars = ParseAAPCustomStringValue(apiWorkflowJobTemplate.ExtraVars)
+	r.AllowSimultaneous = types.BoolValue(apiWorkflowJobTemplate.AllowSimultaneous)
+
+	r.AskVariablesOnLaunch = types.BoolValue(apiWorkflowJobTemplate.AskVariablesOnLaunch)
+	r.AskInventoryOnLaunch = types.BoolValue(apiWorkflowJobTemplate.AskInventoryOnLaunch)
+	r.AskTagsOnLaunch = types.BoolValue(apiWorkflowJobTemplate.AskTagsOnLaunch)
+	r.AskLabelsOnLaunch = types.BoolValue(apiWorkflowJobTemplate.AskLabelsOnLaunch)
+	r.AskLimitOnLaunch = types.BoolValue(apiWorkflowJobTemplate.AskLimitOnLaunch)
+	r.AskScmBranchOnLaunch = types.BoolValue(apiWorkflowJobTemplate.AskScmBranchOnLaunch)
+	r.AskSkipTagsOnLaunch = types.BoolValue(apiWorkflowJobTemplate.AskSkipTagsOnLaunch)
+
+	return diags
+}
+
+// ParseNodes updates the nodes of the workflow job template resource data from the workflow nodes returned by AAP.
+// The nodes keep the order of the current nodes, the other ones being appended by id. Approvals holds the approval
+// templates run by the approval nodes, by id.
+func (r *WorkflowJobTemplateResourceModel) ParseNodes(apiNodes []workflowJobTemplateNodeResponseAPIModel,
+	approvals map[int64]WorkflowApprovalTemplateAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	identifiers := make(map[int64]string, len(apiNodes))
+	for _, apiNode := range apiNodes {
+		identifiers[apiNode.Id] = apiNode.Identifier
+	}
+
+	currentNodes := make(map[string]WorkflowJobTemplateNodeModel, len(r.Nodes))
+	positions := make(map[string]int, len(r.Nodes))
+	for i, node := range r.Nodes {
+		currentNodes[node.Identifier.ValueString()] = node
+		positions[node.Identifier.ValueString()] = i
+	}
+
+	sortedNodes := slices.Clone(apiNodes)
+	slices.SortStableFunc(sortedNodes, func(a, b workflowJobTemplateNodeResponseAPIModel) int {
+		positionA, knownA := positions[a.Identifier]
+		positionB, knownB := positions[b.Identifier]
+		switch {
+		case knownA && knownB:
+			return positionA - positionB
+		case knownA != knownB:
+			if knownA {
+				return -1
+			}
+			return 1
+		default:
+			return int(a.Id - b.Id)
+		}
+	})
+
+	nodes := make([]WorkflowJobTemplateNodeModel, 0, len(sortedNodes))
+	for _, apiNode := range sortedNodes {
+		node := currentNodes[apiNode.Identifier]
+		diags.Append(node.ParseHttpResponse(apiNode, identifiers, approvals)...)
+		if diags.HasError() {
+			return diags
+		}
+		nodes = append(nodes, node)
+	}
+	r.Nodes = nodes
+
+	return diags
+}
+
+// HandleNodes applies the minimal changes to the workflow nodes in AAP so that they match the nodes of the plan:
+// the nodes missing from the plan are deleted, the new nodes are created, the changed nodes are updated and the
+// edges are reconciled. The resulting nodes are then saved into the workflow job template resource model.
+func (r *WorkflowJobTemplateResource) HandleNodes(data *WorkflowJobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nodesURL, diagsURL := getURL(data.Url.ValueString(), "workflow_nodes")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	apiNodes, approvals, diagsRead := r.readNodes(nodesURL)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	expected := make(map[string]bool, len(data.Nodes))
+	for _, node := range data.Nodes {
+		expected[node.Identifier.ValueString()] = true
+	}
+
+	// Delete the nodes missing from the plan, AAP removes their edges
+	currentNodes := make(map[string]workflowJobTemplateNodeResponseAPIModel, len(apiNodes))
+	for _, apiNode := range apiNodes {
+		if expected[apiNode.Identifier] {
+			currentNodes[apiNode.Identifier] = apiNode
+			continue
+		}
+		_, diagsDelete := r.client.Delete(apiNode.Url)
+		diags.Append(diagsDelete...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Create the new nodes and update the changed ones
+	for _, node := range data.Nodes {
+		var currentNode *workflowJobTemplateNodeResponseAPIModel
+		if apiNode, ok := currentNodes[node.Identifier.ValueString()]; ok {
+			currentNode = &apiNode
+		}
+		apiNode, diagsNode := r.applyNode(nodesURL, node, currentNode, approvals)
+		diags.Append(diagsNode...)
+		if diags.HasError() {
+			return diags
+		}
+		currentNodes[node.Identifier.ValueString()] = apiNode
+	}
+
+	ids := make(map[string]int64, len(currentNodes))
+	live := make(map[int64]bool, len(currentNodes))
+	for identifier, apiNode := range currentNodes {
+		ids[identifier] = apiNode.Id
+		live[apiNode.Id] = true
+	}
+
+	// Remove the edges before adding the new ones, so that AAP never sees a cycle while the graph changes
+	for _, disassociate := range []bool{true, false} {
+		for _, node := range data.Nodes {
+			apiNode := currentNodes[node.Identifier.ValueString()]
+			for _, edge := range workflowNodeEdges {
+				expectedIDs, diagsEdge := node.EdgeIDs(edge, ids)
+				diags.Append(diagsEdge...)
+				if diags.HasError() {
+					return diags
+				}
+				currentIDs := apiNode.EdgeIDs(edge, live)
+
+				changes := sliceDifference(expectedIDs, currentIDs)
+				if disassociate {
+					changes = sliceDifference(currentIDs, expectedIDs)
+				}
+				if len(changes) == 0 {
+					continue
+				}
+
+				edgeURL, diagsURL := getURL(apiNode.Url, edge)
+				diags.Append(diagsURL...)
+				if diags.HasError() {
+					return diags
+				}
+				diags.Append(associateIDs(r.client, edgeURL, changes, disassociate)...)
+				if diags.HasError() {
+					return diags
+				}
+			}
+		}
+	}
+
+	diags.Append(r.ReadNodes(data)...)
+	return diags
+}
+
+// applyNode creates the workflow node when it does not exist yet, or updates it when it differs from the plan,
+// and returns the resulting workflow node.
+func (r *WorkflowJobTemplateResource) applyNode(nodesURL string, node WorkflowJobTemplateNodeModel,
+	currentNode *workflowJobTemplateNodeResponseAPIModel,
+	approvals map[int64]WorkflowApprovalTemplateAPIModel) (workflowJobTemplateNodeResponseAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiNode workflowJobTemplateNodeResponseAPIModel
+
+	// Approval nodes keep running their current approval template, which is created separately
+	requestNode := node
+	if node.Approval != nil {
+		requestNode.UnifiedJobTemplate = types.Int64Null()
+		if currentNode != nil && currentNode.IsApproval() {
+			requestNode.UnifiedJobTemplate = types.Int64PointerValue(currentNode.UnifiedJobTemplate)
+		}
+	}
+	requestBody, diagsBody := requestNode.CreateRequestBody()
+	diags.Append(diagsBody...)
+	if diags.HasError() {
+		return apiNode, diags
+	}
+
+	var responseBody []byte
+	if currentNode == nil {
+		responseBody, diags = r.client.Create(nodesURL, bytes.NewReader(requestBody))
+	} else if !currentNode.Matches(requestBody) {
+		responseBody, diags = r.client.Update(currentNode.Url, bytes.NewReader(requestBody))
+	} else {
+		apiNode = *currentNode
+	}
+	if diags.HasError() {
+		return apiNode, diags
+	}
+	if responseBody != nil {
+		err := json.Unmarshal(responseBody, &apiNode)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return apiNode, diags
+		}
+	}
+
+	if node.Approval == nil {
+		return apiNode, diags
+	}
+
+	approvalBody, diagsBody := node.Approval.CreateRequestBody()
+	diags.Append(diagsBody...)
+	if diags.HasError() {
+		return apiNode, diags
+	}
+
+	if !apiNode.IsApproval() {
+		approvalURL, diagsURL := getURL(apiNode.Url, "create_approval_template")
+		diags.Append(diagsURL...)
+		if diags.HasError() {
+			return apiNode, diags
+		}
+		_, diagsCreate := r.client.Create(approvalURL, bytes.NewReader(approvalBody))
+		diags.Append(diagsCreate...)
+		return apiNode, diags
+	}
+
+	currentApprovalBody, err := json.Marshal(approvals[*apiNode.UnifiedJobTemplate])
+	if err != nil {
+		diags.AddError("Body JSON Marshal Error", err.Error())
+		return apiNode, diags
+	}
+	if equal, _ := DeepEqualJSONByte(approvalBody, currentApprovalBody); !equal {
+		approvalURL := fmt.Sprintf("/api/v2/workflow_approval_templates/%d/", *apiNode.UnifiedJobTemplate)
+		_, diagsUpdate := r.client.Update(approvalURL, bytes.NewReader(approvalBody))
+		diags.Append(diagsUpdate...)
+	}
+
+	return apiNode, diags
+}
+
+// ReadNodes saves the nodes of the workflow job template into the workflow job template resource model.
+func (r *WorkflowJobTemplateResource) ReadNodes(data *WorkflowJobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nodesURL, diagsURL := getURL(data.Url.ValueString(), "workflow_nodes")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	apiNodes, approvals, diagsRead := r.readNodes(nodesURL)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.ParseNodes(apiNodes, approvals)...)
+	return diags
+}
+
+// readNodes returns the workflow nodes listed at the provided URL, along with the approval templates run by the
+// approval nodes, by id.
+func (r *WorkflowJobTemplateResource) readNodes(nodesURL string) ([]workflowJobTemplateNodeResponseAPIModel,
+	map[int64]WorkflowApprovalTemplateAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	results, diagsList := getAllResults(r.client, nodesURL)
+	diags.Append(diagsList...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	apiNodes := make([]workflowJobTemplateNodeResponseAPIModel, 0, len(results))
+	approvals := map[int64]WorkflowApprovalTemplateAPIModel{}
+	for _, result := range results {
+		var apiNode workflowJobTemplateNodeResponseAPIModel
+		err := json.Unmarshal(result, &apiNode)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, nil, diags
+		}
+		apiNodes = append(apiNodes, apiNode)
+
+		if !apiNode.IsApproval() {
+			continue
+		}
+		approvalBody, diagsGet := r.client.Get(fmt.Sprintf("/api/v2/workflow_approval_templates/%d/", *apiNode.UnifiedJobTemplate))
+		diags.Append(diagsGet...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		var approval WorkflowApprovalTemplateAPIModel
+		err = json.Unmarshal(approvalBody, &approval)
+		if err != nil {
+			diags.AddError("Error parsing JSON response from AAP", err.Error())
+			return nil, nil, diags
+		}
+		approvals[*apiNode.UnifiedJobTemplate] = approval
+	}
+
+	return apiNodes, approvals, diags
+}
+
+// HandleLabels associates the labels from the plan with the workflow job template, and saves the resulting labels
+// into the workflow job template resource model.
+func (r *WorkflowJobTemplateResource) HandleLabels(ctx context.Context, data *WorkflowJobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if IsValueProvided(data.Labels) {
+		labels := make([]int64, 0, len(data.Labels.Elements()))
+		diags.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		url, diagsURL := getURL(data.Url.ValueString(), "labels")
+		diags.Append(diagsURL...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(reconcileAssociation(r.client, url, labels)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(r.ReadLabels(ctx, data)...)
+	return diags
+}
+
+// ReadLabels saves the labels associated with the workflow job template into the workflow job template resource model.
+func (r *WorkflowJobTemplateResource) ReadLabels(ctx context.Context, data *WorkflowJobTemplateResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	url, diagsURL := getURL(data.Url.ValueString(), "labels")
+	diags.Append(diagsURL...)
+	if diags.HasError() {
+		return diags
+	}
+
+	labels, diagsRead := readAssociatedIDs(r.client, url)
+	diags.Append(diagsRead...)
+	if diags.HasError() {
+		return diags
+	}
+
+	convertedLabels, diagsConvert := types.SetValueFrom(ctx, types.Int64Type, labels)
+	diags.Append(diagsConvert...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Labels = convertedLabels
+
+	return diags
+}
+
+// CreateRequestBody creates a JSON encoded request body from the workflow node data, without its edges
+func (n *WorkflowJobTemplateNodeModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert workflow node data to API data model
+	node := WorkflowJobTemplateNodeAPIModel{
+		Identifier:             n.Identifier.ValueString(),
+		UnifiedJobTemplate:     n.UnifiedJobTemplate.ValueInt64Pointer(),
+		AllParentsMustConverge: n.AllParentsMustConverge.ValueBool(),
+		ExtraData:              json.RawMessage(`{}`),
+		Inventory:              n.Inventory.ValueInt64Pointer(),
+		ExecutionEnvironment:   n.ExecutionEnvironment.ValueInt64Pointer(),
+		ScmBranch:              n.ScmBranch.ValueStringPointer(),
+		JobType:                n.JobType.ValueStringPointer(),
+		JobTags:                n.JobTags.ValueStringPointer(),
+		SkipTags:               n.SkipTags.ValueStringPointer(),
+		Limit:                  n.Limit.ValueStringPointer(),
+		DiffMode:               n.DiffMode.ValueBoolPointer(),
+		Verbosity:              n.Verbosity.ValueInt64Pointer(),
+		Forks:                  n.Forks.ValueInt64Pointer(),
+		JobSliceCount:          n.JobSliceCount.ValueInt64Pointer(),
+		Timeout:                n.Timeout.ValueInt64Pointer(),
+	}
+
+	if IsValueProvided(n.ExtraData) {
+		extraData, err := decodeJSONOrYAML(n.ExtraData.ValueString())
+		if err == nil {
+			node.ExtraData, err = json.Marshal(extraData)
+		}
+		if err != nil {
+			diags.AddError(
+				"Error marshaling request body",
+				fmt.Sprintf("Could not decode the extra data of workflow node %q, unexpected error: %s", node.Identifier, err.Error()),
+			)
+			return nil, diags
+		}
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(node)
+	if err != nil {
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for workflow node %q, unexpected error: %s", node.Identifier, err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, diags
+}
+
+// ParseHttpResponse updates the workflow node data from a workflow node returned by AAP. Identifiers holds the
+// identifiers of the workflow nodes by id, and approvals the approval templates run by the approval nodes by id.
+func (n *WorkflowJobTemplateNodeModel) ParseHttpResponse(apiNode workflowJobTemplateNodeResponseAPIModel,
+	identifiers map[int64]string, approvals map[int64]WorkflowApprovalTemplateAPIModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	n.Identifier = types.StringValue(apiNode.Identifier)
+	if apiNode.IsApproval() {
+		approval := approvals[*apiNode.UnifiedJobTemplate]
+		n.UnifiedJobTemplate = types.Int64Null()
+		n.Approval = &WorkflowApprovalModel{
+			Name:        types.StringValue(approval.Name),
+			Description: ParseStringValue(approval.Description),
+			Timeout:     types.Int64Null(),
+		}
+		// AAP uses a zero timeout for approvals which never time out
+		if approval.Timeout != 0 {
+			n.Approval.Timeout = types.Int64Value(approval.Timeout)
+		}
+	} else {
+		n.UnifiedJobTemplate = types.Int64PointerValue(apiNode.UnifiedJobTemplate)
+		n.Approval = nil
+	}
+
+	for _, edge := range []struct {
+		value *types.Set
+		ids   []int64
+	}{
+		{&n.SuccessNodes, apiNode.SuccessNodes},
+		{&n.FailureNodes, apiNode.FailureNodes},
+		{&n.AlwaysNodes, apiNode.AlwaysNodes},
+	} {
+		// Nodes without children keep a null value when no edges are configured
+		if len(edge.ids) == 0 && edge.value.IsNull() {
+			continue
+		}
+		children := make([]attr.Value, 0, len(edge.ids))
+		for _, id := range edge.ids {
+			children = append(children, types.StringValue(identifiers[id]))
+		}
+		value, diagsSet := types.SetValue(types.StringType, children)
+		diags.Append(diagsSet...)
+		*edge.value = value
+	}
+
+	if apiNode.AllParentsMustConverge || !n.AllParentsMustConverge.IsNull() {
+		n.AllParentsMustConverge = types.BoolValue(apiNode.AllParentsMustConverge)
+	}
+	n.ExtraData = parseJSONObjectValue(n.ExtraData, apiNode.ExtraData)
+	n.Inventory = types.Int64PointerValue(apiNode.Inventory)
+	n.ExecutionEnvironment = types.Int64PointerValue(apiNode.ExecutionEnvironment)
+	n.ScmBranch = ParseStringPointerValue(apiNode.ScmBranch)
+	n.JobType = ParseStringPointerValue(apiNode.JobType)
+	n.JobTags = ParseStringPointerValue(apiNode.JobTags)
+	n.SkipTags = ParseStringPointerValue(apiNode.SkipTags)
+	n.Limit = ParseStringPointerValue(apiNode.Limit)
+	n.DiffMode = types.BoolPointerValue(apiNode.DiffMode)
+	n.Verbosity = types.Int64PointerValue(apiNode.Verbosity)
+	n.Forks = types.Int64PointerValue(apiNode.Forks)
+	n.JobSliceCount = types.Int64PointerValue(apiNode.JobSliceCount)
+	n.Timeout = types.Int64PointerValue(apiNode.Timeout)
+
+	return diags
+}
+
+// EdgeIDs returns the ids of the children of the workflow node for the provided kind of edge, from the ids of the
+// workflow nodes by identifier.
+func (n *WorkflowJobTemplateNodeModel) EdgeIDs(edge string, ids map[string]int64) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value := map[string]types.Set{
+		"success_nodes": n.SuccessNodes,
+		"failure_nodes": n.FailureNodes,
+		"always_nodes":  n.AlwaysNodes,
+	}[edge]
+
+	var identifiers []string
+	diags.Append(value.ElementsAs(context.Background(), &identifiers, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	children := make([]int64, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		children = append(children, ids[identifier])
+	}
+
+	return children, diags
+}
+
+// CreateRequestBody creates a JSON encoded request body from the workflow approval data
+func (a *WorkflowApprovalModel) CreateRequestBody() ([]byte, diag.Diagnostics) {
+	// Convert workflow approval data to API data model
+	approval := WorkflowApprovalTemplateAPIModel{
+		Name:        a.Name.ValueString(),
+		Description: a.Description.ValueString(),
+		Timeout:     a.Timeout.ValueInt64(),
+	}
+
+	// Create JSON encoded request body
+	jsonBody, err := json.Marshal(approval)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error marshaling request body",
+			fmt.Sprintf("Could not create request body for workflow approval, unexpected error: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	return jsonBody, nil
+}
+
+// IsApproval reports whether the workflow node runs an approval template.
+func (n *workflowJobTemplateNodeResponseAPIModel) IsApproval() bool {
+	return n.UnifiedJobTemplate != nil && n.SummaryFields.UnifiedJobTemplate.UnifiedJobType == workflowApprovalJobType
+}
+
+// EdgeIDs returns the ids of the children of the workflow node for the provided kind of edge, ignoring the nodes
+// which are no longer part of the workflow.
+func (n *workflowJobTemplateNodeResponseAPIModel) EdgeIDs(edge string, live map[int64]bool) []int64 {
+	children := map[string][]int64{
+		"success_nodes": n.SuccessNodes,
+		"failure_nodes": n.FailureNodes,
+		"always_nodes":  n.AlwaysNodes,
+	}[edge]
+
+	var current []int64
+	for _, child := range children {
+		if live[child] {
+			current = append(current, child)
+		}
+	}
+	return current
+}
+
+// Matches reports whether the workflow node already holds the data of the provided request body.
+func (n *workflowJobTemplateNodeResponseAPIModel) Matches(requestBody []byte) bool {
+	currentBody, err := json.Marshal(n.WorkflowJobTemplateNodeAPIModel)
+	if err != nil {
+		return false
+	}
+	equal, err := DeepEqualJSONByte(requestBody, currentBody)
+	return err == nil && equal
+}
+
+// validateWorkflowNodes checks that the node identifiers are unique and that the edges only reference declared nodes.
+func validateWorkflowNodes(nodes []WorkflowJobTemplateNodeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	declared := make(map[string]bool, len(nodes))
+	for i, node := range nodes {
+		if node.Identifier.IsUnknown() || node.Identifier.IsNull() {
+			continue
+		}
+		identifier := node.Identifier.ValueString()
+		if declared[identifier] {
+			diags.AddAttributeError(
+				path.Root("node").AtListIndex(i).AtName("identifier"),
+				"Duplicate workflow node identifier",
+				fmt.Sprintf("The identifier %q is used by more than one node.", identifier),
+			)
+		}
+		declared[identifier] = true
+	}
+
+	for i, node := range nodes {
+		for _, edge := range []struct {
+			name  string
+			value types.Set
+		}{
+			{"success_nodes", node.SuccessNodes},
+			{"failure_nodes", node.FailureNodes},
+			{"always_nodes", node.AlwaysNodes},
+		} {
+			if edge.value.IsUnknown() {
+				continue
+			}
+			for _, element := range edge.value.Elements() {
+				child, ok := element.(types.String)
+				if !ok || child.IsUnknown() {
+					continue
+				}
+				if !declared[child.ValueString()] {
+					diags.AddAttributeError(
+						path.Root("node").AtListIndex(i).AtName(edge.name),
+						"Unknown workflow node identifier",
+						fmt.Sprintf("The edge references %q, which is not the identifier of a node.", child.ValueString()),
+					)
+				}
+				if child.Equal(node.Identifier) {
+					diags.AddAttributeError(
+						path.Root("node").AtListIndex(i).AtName(edge.name),
+						"Invalid workflow node edge",
+						fmt.Sprintf("The node %q can not be its own child.", child.ValueString()),
+					)
+				}
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,634 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ansible/terraform-provider-aap/internal/provider/customtypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestWorkflowJobTemplateResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	// Instantiate the WorkflowJobTemplateResource and call its Schema method
+	NewWorkflowJobTemplateResource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestWorkflowJobTemplateResourceCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    WorkflowJobTemplateResourceModel
+		expected []byte
+	}{
+		{
+			name: "required values",
+			input: WorkflowJobTemplateResourceModel{
+				Name:         types.StringValue("provisioning"),
+				Organization: types.Int64Null(),
+				Inventory:    types.Int64Null(),
+			},
+			expected: []byte(`{"name":"provisioning","description":"","organization":null,"inventory":null,"limit":"",` +
+				`"scm_branch":"","job_tags":"","skip_tags":"","extra_vars":"","allow_simultaneous":false,` +
+				`"ask_variables_on_launch":false,"ask_inventory_on_launch":false,"ask_tags_on_launch":false,` +
+				`"ask_labels_on_launch":false,"ask_limit_on_launch":false,"ask_scm_branch_on_launch":false,` +
+				`"ask_skip_tags_on_launch":false}`),
+		},
+		{
+			name: "provided values",
+			input: WorkflowJobTemplateResourceModel{
+				Name:                 types.StringValue("provisioning"),
+				Description:          types.StringValue("Provision the servers"),
+				Organization:         types.Int64Value(1),
+				Inventory:            types.Int64Value(2),
+				Limit:                types.StringValue("webservers"),
+				ExtraVars:            customtypes.NewAAPCustomStringValue(`{"region":"eu"}`),
+				AllowSimultaneous:    types.BoolValue(true),
+				AskInventoryOnLaunch: types.BoolValue(true),
+			},
+			expected: []byte(`{"name":"provisioning","description":"Provision the servers","organization":1,"inventory":2,` +
+				`"limit":"webservers","scm_branch":"","job_tags":"","skip_tags":"","extra_vars":"{\"region\":\"eu\"}",` +
+				`"allow_simultaneous":true,"ask_variables_on_launch":false,"ask_inventory_on_launch":true,` +
+				`"ask_tags_on_launch":false,"ask_labels_on_launch":false,"ask_limit_on_launch":false,` +
+				`"ask_scm_branch_on_launch":false,"ask_skip_tags_on_launch":false}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobTemplateResourceParseHttpResponse(t *testing.T) {
+	jsonError := diag.Diagnostics{}
+	jsonError.AddError("Error parsing JSON response from AAP", "invalid character 'N' looking for beginning of value")
+
+	var testTable = []struct {
+		name     string
+		input    []byte
+		expected WorkflowJobTemplateResourceModel
+		errors   diag.Diagnostics
+	}{
+		{
+			name:     "JSON error",
+			input:    []byte("Not valid JSON"),
+			expected: WorkflowJobTemplateResourceModel{},
+			errors:   jsonError,
+		},
+		{
+			name: "provided values",
+			input: []byte(`{"id":4,"url":"/api/v2/workflow_job_templates/4/","name":"provisioning","description":"",` +
+				`"organization":1,"inventory":null,"limit":null,"scm_branch":"main","job_tags":"","skip_tags":"",` +
+				`"extra_vars":"","allow_simultaneous":false,"ask_variables_on_launch":true,"ask_inventory_on_launch":false,` +
+				`"ask_tags_on_launch":false,"ask_labels_on_launch":false,"ask_limit_on_launch":true,` +
+				`"ask_scm_branch_on_launch":false,"ask_skip_tags_on_launch":false}`),
+			expected: WorkflowJobTemplateResourceModel{
+				Id:                   types.Int64Value(4),
+				Url:                  types.StringValue("/api/v2/workflow_job_templates/4/"),
+				Name:                 types.StringValue("provisioning"),
+				Description:          types.StringNull(),
+				Organization:         types.Int64Value(1),
+				Inventory:            types.Int64Null(),
+				Limit:                types.StringNull(),
+				ScmBranch:            types.StringValue("main"),
+				JobTags:              types.StringNull(),
+				SkipTags:             types.StringNull(),
+				ExtraVars:            customtypes.NewAAPCustomStringNull(),
+				AllowSimultaneous:    types.BoolValue(false),
+				AskVariablesOnLaunch: types.BoolValue(true),
+				AskInventoryOnLaunch: types.BoolValue(false),
+				AskTagsOnLaunch:      types.BoolValue(false),
+				AskLabelsOnLaunch:    types.BoolValue(false),
+				AskLimitOnLaunch:     types.BoolValue(true),
+				AskScmBranchOnLaunch: types.BoolValue(false),
+				AskSkipTagsOnLaunch:  types.BoolValue(false),
+			},
+			errors: diag.Diagnostics{},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			resource := WorkflowJobTemplateResourceModel{}
+			diags := resource.ParseHttpResponse(test.input)
+			if !test.errors.Equal(diags) {
+				t.Errorf("Expected error diagnostics (%s), actual was (%s)", test.errors, diags)
+			}
+			if !reflect.DeepEqual(test.expected, resource) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, resource)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobTemplateNodeCreateRequestBody(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    WorkflowJobTemplateNodeModel
+		expected []byte
+	}{
+		{
+			name: "required values",
+			input: WorkflowJobTemplateNodeModel{
+				Identifier:         types.StringValue("deploy"),
+				UnifiedJobTemplate: types.Int64Value(7),
+			},
+			expected: []byte(`{"identifier":"deploy","unified_job_template":7,"all_parents_must_converge":false,` +
+				`"extra_data":{},"inventory":null,"execution_environment":null,"scm_branch":null,"job_type":null,` +
+				`"job_tags":null,"skip_tags":null,"limit":null,"diff_mode":null,"verbosity":null,"forks":null,` +
+				`"job_slice_count":null,"timeout":null}`),
+		},
+		{
+			name: "prompted values",
+			input: WorkflowJobTemplateNodeModel{
+				Identifier:             types.StringValue("deploy"),
+				UnifiedJobTemplate:     types.Int64Value(7),
+				AllParentsMustConverge: types.BoolValue(true),
+				ExtraData:              customtypes.NewAAPCustomStringValue("region: eu"),
+				Limit:                  types.StringValue("webservers"),
+				Verbosity:              types.Int64Value(2),
+			},
+			expected: []byte(`{"identifier":"deploy","unified_job_template":7,"all_parents_must_converge":true,` +
+				`"extra_data":{"region":"eu"},"inventory":null,"execution_environment":null,"scm_branch":null,"job_type":null,` +
+				`"job_tags":null,"skip_tags":null,"limit":"webservers","diff_mode":null,"verbosity":2,"forks":null,` +
+				`"job_slice_count":null,"timeout":null}`),
+		},
+		{
+			name: "approval node",
+			input: WorkflowJobTemplateNodeModel{
+				Identifier: types.StringValue("approve"),
+				Approval:   &WorkflowApprovalModel{Name: types.StringValue("Approve deployment")},
+			},
+			expected: []byte(`{"identifier":"approve","unified_job_template":null,"all_parents_must_converge":false,` +
+				`"extra_data":{},"inventory":null,"execution_environment":null,"scm_branch":null,"job_type":null,` +
+				`"job_tags":null,"skip_tags":null,"limit":null,"diff_mode":null,"verbosity":null,"forks":null,` +
+				`"job_slice_count":null,"timeout":null}`),
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			actual, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			equal, err := DeepEqualJSONByte(test.expected, actual)
+			if err != nil {
+				t.Fatal("Error while comparing results " + err.Error())
+			}
+			if !equal {
+				t.Errorf("Expected (%s) not equal to actual (%s)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobTemplateNodeMatches(t *testing.T) {
+	var apiNode workflowJobTemplateNodeResponseAPIModel
+	err := json.Unmarshal([]byte(`{"id":10,"url":"/api/v2/workflow_job_template_nodes/10/","identifier":"deploy",`+
+		`"unified_job_template":7,"all_parents_must_converge":false,"extra_data":{"b":2,"a":1},"inventory":null,`+
+		`"execution_environment":null,"scm_branch":null,"job_type":null,"job_tags":null,"skip_tags":null,`+
+		`"limit":null,"diff_mode":null,"verbosity":null,"forks":null,"job_slice_count":null,"timeout":null,`+
+		`"success_nodes":[11],"failure_nodes":[],"always_nodes":[]}`), &apiNode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var testTable = []struct {
+		name     string
+		input    WorkflowJobTemplateNodeModel
+		expected bool
+	}{
+		{
+			name: "same node",
+			input: WorkflowJobTemplateNodeModel{
+				Identifier:         types.StringValue("deploy"),
+				UnifiedJobTemplate: types.Int64Value(7),
+				ExtraData:          customtypes.NewAAPCustomStringValue("a: 1\nb: 2"),
+			},
+			expected: true,
+		},
+		{
+			name: "changed prompt",
+			input: WorkflowJobTemplateNodeModel{
+				Identifier:         types.StringValue("deploy"),
+				UnifiedJobTemplate: types.Int64Value(7),
+				ExtraData:          customtypes.NewAAPCustomStringValue("a: 1\nb: 2"),
+				Limit:              types.StringValue("webservers"),
+			},
+			expected: false,
+		},
+		{
+			name: "changed template",
+			input: WorkflowJobTemplateNodeModel{
+				Identifier:         types.StringValue("deploy"),
+				UnifiedJobTemplate: types.Int64Value(8),
+				ExtraData:          customtypes.NewAAPCustomStringValue("a: 1\nb: 2"),
+			},
+			expected: false,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			requestBody, diags := test.input.CreateRequestBody()
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if actual := apiNode.Matches(requestBody); actual != test.expected {
+				t.Errorf("Expected (%t) not equal to actual (%t)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobTemplateResourceParseNodes(t *testing.T) {
+	results := []byte(`[` +
+		`{"id":10,"url":"/api/v2/workflow_job_template_nodes/10/","identifier":"notify","unified_job_template":8,` +
+		`"all_parents_must_converge":false,"extra_data":{},"success_nodes":[],"failure_nodes":[],"always_nodes":[],` +
+		`"summary_fields":{"unified_job_template":{"unified_job_type":"job"}}},` +
+		`{"id":11,"url":"/api/v2/workflow_job_template_nodes/11/","identifier":"approve","unified_job_template":20,` +
+		`"all_parents_must_converge":false,"extra_data":{},"success_nodes":[12],"failure_nodes":[],"always_nodes":[],` +
+		`"summary_fields":{"unified_job_template":{"unified_job_type":"workflow_approval"}}},` +
+		`{"id":12,"url":"/api/v2/workflow_job_template_nodes/12/","identifier":"deploy","unified_job_template":7,` +
+		`"all_parents_must_converge":false,"extra_data":{"region":"eu"},"limit":"webservers","success_nodes":[],` +
+		`"failure_nodes":[10],"always_nodes":[],"summary_fields":{"unified_job_template":{"unified_job_type":"job"}}}` +
+		`]`)
+	var apiNodes []workflowJobTemplateNodeResponseAPIModel
+	err := json.Unmarshal(results, &apiNodes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	approvals := map[int64]WorkflowApprovalTemplateAPIModel{
+		20: {Name: "Approve deployment", Timeout: 3600},
+	}
+
+	resource := WorkflowJobTemplateResourceModel{
+		Nodes: []WorkflowJobTemplateNodeModel{
+			{
+				Identifier:   types.StringValue("approve"),
+				FailureNodes: types.SetValueMust(types.StringType, []attr.Value{}),
+			},
+			{
+				Identifier: types.StringValue("deploy"),
+				ExtraData:  customtypes.NewAAPCustomStringValue("region: eu"),
+			},
+		},
+	}
+	diags := resource.ParseNodes(apiNodes, approvals)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	expected := []WorkflowJobTemplateNodeModel{
+		{
+			Identifier:         types.StringValue("approve"),
+			UnifiedJobTemplate: types.Int64Null(),
+			Approval: &WorkflowApprovalModel{
+				Name:        types.StringValue("Approve deployment"),
+				Description: types.StringNull(),
+				Timeout:     types.Int64Value(3600),
+			},
+			SuccessNodes: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("deploy")}),
+			FailureNodes: types.SetValueMust(types.StringType, []attr.Value{}),
+			ExtraData:    customtypes.NewAAPCustomStringNull(),
+		},
+		{
+			Identifier:         types.StringValue("deploy"),
+			UnifiedJobTemplate: types.Int64Value(7),
+			FailureNodes:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("notify")}),
+			ExtraData:          customtypes.NewAAPCustomStringValue("region: eu"),
+			Limit:              types.StringValue("webservers"),
+		},
+		{
+			Identifier:         types.StringValue("notify"),
+			UnifiedJobTemplate: types.Int64Value(8),
+			ExtraData:          customtypes.NewAAPCustomStringNull(),
+		},
+	}
+	if !reflect.DeepEqual(expected, resource.Nodes) {
+		t.Errorf("Expected (%v) not equal to actual (%v)", expected, resource.Nodes)
+	}
+}
+
+func TestValidateWorkflowNodes(t *testing.T) {
+	var testTable = []struct {
+		name     string
+		input    []WorkflowJobTemplateNodeModel
+		expected []string
+	}{
+		{
+			name: "valid graph",
+			input: []WorkflowJobTemplateNodeModel{
+				{
+					Identifier:   types.StringValue("deploy"),
+					SuccessNodes: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("notify")}),
+				},
+				{Identifier: types.StringValue("notify")},
+			},
+			expected: nil,
+		},
+		{
+			name: "duplicate identifier",
+			input: []WorkflowJobTemplateNodeModel{
+				{Identifier: types.StringValue("deploy")},
+				{Identifier: types.StringValue("deploy")},
+			},
+			expected: []string{"Duplicate workflow node identifier"},
+		},
+		{
+			name: "unknown child",
+			input: []WorkflowJobTemplateNodeModel{
+				{
+					Identifier:  types.StringValue("deploy"),
+					AlwaysNodes: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("cleanup")}),
+				},
+			},
+			expected: []string{"Unknown workflow node identifier"},
+		},
+		{
+			name: "self edge",
+			input: []WorkflowJobTemplateNodeModel{
+				{
+					Identifier:   types.StringValue("deploy"),
+					FailureNodes: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("deploy")}),
+				},
+			},
+			expected: []string{"Invalid workflow node edge"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			var actual []string
+			for _, err := range validateWorkflowNodes(test.input).Errors() {
+				actual = append(actual, err.Summary())
+			}
+			if !reflect.DeepEqual(test.expected, actual) {
+				t.Errorf("Expected (%v) not equal to actual (%v)", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobTemplateResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	NewWorkflowJobTemplateResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	// Reading the nodes of the created workflow job template fails, as it is not mocked
+	r := WorkflowJobTemplateResource{client: NewMockRawHTTPClient(map[string]MockRawResponse{
+		"POST /api/v2/workflow_job_templates/": {
+			StatusCode: http.StatusCreated,
+			Body:       `{"id":5,"url":"/api/v2/workflow_job_templates/5/","name":"deploy","organization":1}`,
+		},
+	})}
+
+	plan := tfsdk.Plan{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, &WorkflowJobTemplateResourceModel{
+		Id:                   types.Int64Unknown(),
+		Url:                  types.StringUnknown(),
+		Name:                 types.StringValue("deploy"),
+		Description:          types.StringNull(),
+		Organization:         types.Int64Value(1),
+		Inventory:            types.Int64Null(),
+		Limit:                types.StringNull(),
+		ScmBranch:            types.StringNull(),
+		JobTags:              types.StringNull(),
+		SkipTags:             types.StringNull(),
+		ExtraVars:            customtypes.NewAAPCustomStringNull(),
+		AllowSimultaneous:    types.BoolNull(),
+		Labels:               types.SetUnknown(types.Int64Type),
+		AskVariablesOnLaunch: types.BoolNull(),
+		AskInventoryOnLaunch: types.BoolNull(),
+		AskTagsOnLaunch:      types.BoolNull(),
+		AskLabelsOnLaunch:    types.BoolNull(),
+		AskLimitOnLaunch:     types.BoolNull(),
+		AskScmBranchOnLaunch: types.BoolNull(),
+		AskSkipTagsOnLaunch:  types.BoolNull(),
+	})
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+
+	req := fwresource.CreateRequest{Plan: plan}
+	resp := &fwresource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Create(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error reading the workflow job template nodes")
+	}
+
+	// The created workflow job template is saved into the state even though handling its nodes failed
+	var state WorkflowJobTemplateResourceModel
+	diags = resp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatal(diags.Errors())
+	}
+	if state.Url.ValueString() != "/api/v2/workflow_job_templates/5/" {
+		t.Errorf("Expected created workflow job template (/api/v2/workflow_job_templates/5/), actual was (%s)", state.Url)
+	}
+}
+
+// Acceptance tests
+
+func TestAccWorkflowJobTemplateResource(t *testing.T) {
+	var workflowJobTemplate WorkflowJobTemplateAPIModel
+	var nodeIDs, updatedNodeIDs map[string]int64
+	jobTemplateID := os.Getenv("AAP_TEST_JOB_TEMPLATE_ID")
+	randomName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccJobResourcePreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowJobTemplateResource(randomName, jobTemplateID, "notify"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowJobTemplateResourceExists("aap_workflow_job_template.test", &workflowJobTemplate),
+					testAccCheckWorkflowJobTemplateNodeIDs(&workflowJobTemplate, &nodeIDs),
+					resource.TestCheckResourceAttr("aap_workflow_job_template.test", "name", randomName),
+					resource.TestCheckResourceAttr("aap_workflow_job_template.test", "node.#", "3"),
+					resource.TestCheckResourceAttr("aap_workflow_job_template.test", "node.0.approval.name", "Approve deployment"),
+					resource.TestCheckResourceAttr("aap_workflow_job_template.test", "node.1.failure_nodes.0", "notify"),
+				),
+			},
+			// Update and Read testing, only the notification node is replaced
+			{
+				Config: testAccWorkflowJobTemplateResource(randomName, jobTemplateID, "rollback"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowJobTemplateResourceExists("aap_workflow_job_template.test", &workflowJobTemplate),
+					testAccCheckWorkflowJobTemplateNodeIDs(&workflowJobTemplate, &updatedNodeIDs),
+					resource.TestCheckResourceAttr("aap_workflow_job_template.test", "node.#", "3"),
+					resource.TestCheckResourceAttr("aap_workflow_job_template.test", "node.1.failure_nodes.0", "rollback"),
+					func(_ *terraform.State) error {
+						for _, identifier := range []string{"approve", "deploy"} {
+							if nodeIDs[identifier] != updatedNodeIDs[identifier] {
+								return fmt.Errorf("workflow node %q was replaced", identifier)
+							}
+						}
+						return nil
+					},
+				),
+			},
+			// Import by organization name and name testing
+			{
+				ResourceName:      "aap_workflow_job_template.test",
+				ImportState:       true,
+				ImportStateId:     "Default/" + randomName,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckWorkflowJobTemplateResourceDestroy,
+	})
+}
+
+// testAccWorkflowJobTemplateResource returns a configuration for an AAP Workflow Job Template running the job template
+// after an approval, and running the node with the provided identifier when the job fails.
+func testAccWorkflowJobTemplateResource(name string, jobTemplateID string, failureNode string) string {
+	return fmt.Sprintf(`
+resource "aap_workflow_job_template" "test" {
+  name         = "%[1]s"
+  organization = 1
+
+  node {
+    identifier    = "approve"
+    success_nodes = ["deploy"]
+    approval = {
+      name    = "Approve deployment"
+      timeout = 3600
+    }
+  }
+
+  node {
+    identifier           = "deploy"
+    unified_job_template = %[2]s
+    limit                = "all"
+    failure_nodes        = ["%[3]s"]
+  }
+
+  node {
+    identifier           = "%[3]s"
+    unified_job_template = %[2]s
+  }
+}`, name, jobTemplateID, failureNode)
+}
+
+// testAccCheckWorkflowJobTemplateResourceExists queries the AAP API and retrieves the matching workflow job template.
+func testAccCheckWorkflowJobTemplateResourceExists(name string, workflowJobTemplate *WorkflowJobTemplateAPIModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		workflowJobTemplateResource, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("workflow job template (%s) not found in state", name)
+		}
+
+		workflowJobTemplateResponseBody, err := testGetResource(workflowJobTemplateResource.Primary.Attributes["url"])
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(workflowJobTemplateResponseBody, &workflowJobTemplate)
+		if err != nil {
+			return err
+		}
+
+		if workflowJobTemplate.Id == 0 {
+			return fmt.Errorf("workflow job template (%s) not found in AAP", workflowJobTemplateResource.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckWorkflowJobTemplateNodeIDs queries the AAP API and retrieves the ids of the workflow nodes by identifier.
+func testAccCheckWorkflowJobTemplateNodeIDs(workflowJobTemplate *WorkflowJobTemplateAPIModel, nodeIDs *map[string]int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		nodesResponseBody, err := testGetResource(workflowJobTemplate.Url + "workflow_nodes/")
+		if err != nil {
+			return err
+		}
+
+		var nodes struct {
+			Results []workflowJobTemplateNodeResponseAPIModel `json:"results"`
+		}
+		err = json.Unmarshal(nodesResponseBody, &nodes)
+		if err != nil {
+			return err
+		}
+
+		*nodeIDs = map[string]int64{}
+		for _, node := range nodes.Results {
+			(*nodeIDs)[node.Identifier] = node.Id
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkflowJobTemplateResourceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aap_workflow_job_template" {
+			continue
+		}
+
+		_, err := testGetResource(rs.Primary.Attributes["url"])
+		if err == nil {
+			return fmt.Errorf("workflow job template (%s) still exists.", rs.Primary.Attributes["id"])
+		}
+
+		if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+
+	return nil
+}